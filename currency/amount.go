@@ -0,0 +1,281 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+
+	inumber "golang.org/x/text/internal/number"
+)
+
+var (
+	errMismatch  = errors.New("currency: amounts have different currencies")
+	errNotNumber = errors.New("currency: amount is not a finite number")
+	errRange     = errors.New("currency: amount out of range")
+)
+
+// exact converts numbers to decimals without rounding. Numbers without a
+// finite decimal representation, such as 1/3, are converted to 34 significant
+// digits.
+var exact = inumber.RoundingContext{
+	MaxSignificantDigits: -1,
+	MaxFractionDigits:    -1,
+}
+
+// decimal converts the amount of a to a decimal without rounding.
+func (a Amount) decimal() *inumber.Decimal {
+	d := new(inumber.Decimal)
+	if a.amount == nil {
+		d.NaN = true
+		return d
+	}
+	d.Convert(exact, a.amount)
+	return d
+}
+
+// Rat returns the value of a as a *big.Rat or nil if a is not a finite
+// number. Floating-point values are converted using the shortest decimal
+// representation that converts back to the same value, so that 0.1 yields
+// 1/10. The result is a new value that may be modified by the caller.
+func (a Amount) Rat() *big.Rat {
+	switch x := a.amount.(type) {
+	case *big.Rat:
+		if x == nil {
+			return nil
+		}
+		return new(big.Rat).Set(x)
+	case *big.Int:
+		if x == nil {
+			return nil
+		}
+		return new(big.Rat).SetInt(x)
+	}
+	d := a.decimal()
+	if d.NaN || d.Inf {
+		return nil
+	}
+	r, _ := new(big.Rat).SetString(d.String())
+	return r
+}
+
+// Decimal returns the value of a as a decimal string, such as "-12.5", without
+// rounding it to the scale of the currency. A value without a finite decimal
+// representation, such as 1/3, is rounded to 34 significant digits. Values
+// that are not finite numbers are returned as "NaN", "Inf", or "-Inf".
+func (a Amount) Decimal() string {
+	return a.decimal().String()
+}
+
+// Scale reports the number of fraction digits of the decimal returned by
+// Decimal.
+func (a Amount) Scale() uint {
+	_, frac, _ := strings.Cut(a.Decimal(), ".")
+	return uint(len(frac))
+}
+
+// Sign returns -1, 0, or +1 depending on whether a is negative, zero, or
+// positive. It returns 0 if a is not a finite number.
+func (a Amount) Sign() int {
+	if r := a.Rat(); r != nil {
+		return r.Sign()
+	}
+	return 0
+}
+
+// Int returns the integer part of a, truncated towards zero. It returns an
+// error if a is not a finite number or its integer part does not fit in an
+// int64.
+func (a Amount) Int() (int64, error) {
+	r := a.Rat()
+	if r == nil {
+		return 0, errNotNumber
+	}
+	i := new(big.Int).Quo(r.Num(), r.Denom())
+	if !i.IsInt64() {
+		return 0, errRange
+	}
+	return i.Int64(), nil
+}
+
+// Fraction returns the fraction digits of a as an integer, so that a equals
+// Int() + Fraction() / 10^Scale(). For example, the fraction of -12.05 is -5.
+// It returns an error if a is not a finite number or the fraction digits do
+// not fit in an int64.
+func (a Amount) Fraction() (int64, error) {
+	s := a.Decimal()
+	if s == "NaN" || strings.HasSuffix(s, "Inf") {
+		return 0, errNotNumber
+	}
+	_, frac, _ := strings.Cut(s, ".")
+	if len(frac) > 18 {
+		return 0, errRange
+	}
+	var f int64
+	for _, c := range frac {
+		f = f*10 + int64(c-'0')
+	}
+	if strings.HasPrefix(s, "-") {
+		f = -f
+	}
+	return f, nil
+}
+
+// Float returns the float64 value nearest to a. It returns an error if a is not
+// a finite number or is too large to be represented as a float64.
+func (a Amount) Float() (float64, error) {
+	r := a.Rat()
+	if r == nil {
+		return 0, errNotNumber
+	}
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return f, errRange
+	}
+	return f, nil
+}
+
+// Add returns the sum of a and b. It returns an error if a and b have
+// different currencies or if either is not a finite number.
+func (a Amount) Add(b Amount) (Amount, error) {
+	x, y, err := operands(a, b)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{amount: x.Add(x, y), currency: a.currency}, nil
+}
+
+// Sub returns the difference a - b. It returns an error if a and b have
+// different currencies or if either is not a finite number.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	x, y, err := operands(a, b)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{amount: x.Sub(x, y), currency: a.currency}, nil
+}
+
+func operands(a, b Amount) (x, y *big.Rat, err error) {
+	if a.currency != b.currency {
+		return nil, nil, errMismatch
+	}
+	x, y = a.Rat(), b.Rat()
+	if x == nil || y == nil {
+		return nil, nil, errNotNumber
+	}
+	return x, y, nil
+}
+
+// Mul returns a multiplied by the ratio x, such as a tax rate or an exchange
+// rate between amounts of the same currency. The result is exact; use Round to
+// round it to the scale of the currency. The result is not a number if a is
+// not a finite number.
+func (a Amount) Mul(x *big.Rat) Amount {
+	r := a.Rat()
+	if r != nil {
+		r.Mul(r, x)
+	}
+	return Amount{amount: r, currency: a.currency}
+}
+
+// Round returns a rounded to the scale and increment that kind k defines for
+// the currency of a. Halfway values are rounded away from zero, which is also
+// the default for formatting amounts.
+func (a Amount) Round(k Kind) Amount {
+	r := a.Rat()
+	if r == nil {
+		return Amount{amount: r, currency: a.currency}
+	}
+	var d inumber.Decimal
+	d.ConvertRat(roundingContext(k, a.currency, inumber.ToNearestAway), r)
+	r.SetString(d.String())
+	return Amount{amount: r, currency: a.currency}
+}
+
+// roundingContext returns the rounding context for amounts of currency c
+// rounded according to kind k and mode.
+func roundingContext(k Kind, c Unit, mode inumber.RoundingMode) inumber.RoundingContext {
+	scale, increment := k.Rounding(c)
+	var r inumber.RoundingContext
+	r.SetScale(scale)
+	r.Increment = uint32(increment)
+	r.IncrementScale = uint8(scale)
+	r.Mode = mode
+	return r
+}
+
+// Allocate splits a into parts proportional to the given ratios. Each part is
+// a multiple of the rounding increment that kind k defines for the currency
+// of a, and the parts add up to a rounded according to k. Increments that
+// cannot be distributed proportionally are added one at a time to the first
+// parts. For example, allocating USD 0.05 in the ratios 3 and 7 yields USD 0.02
+// and USD 0.03.
+//
+// Allocate returns nil if a is not a finite number. It panics if a ratio is
+// negative or if the ratios add up to zero.
+func (a Amount) Allocate(k Kind, ratios ...int) []Amount {
+	total := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			panic("currency: negative ratio")
+		}
+		total.Add(total, big.NewInt(int64(r)))
+	}
+	if total.Sign() == 0 {
+		panic("currency: ratios add up to zero")
+	}
+	r := a.Round(k).Rat()
+	if r == nil {
+		return nil
+	}
+	// Express the amount in units of the rounding increment.
+	scale, increment := k.Rounding(a.currency)
+	unit := new(big.Rat).SetFrac(big.NewInt(int64(increment)), pow10(scale))
+	n := new(big.Rat).Quo(r, unit).Num()
+	neg := n.Sign() < 0
+	n.Abs(n)
+
+	shares := make([]*big.Int, len(ratios))
+	rest := new(big.Int).Set(n)
+	for i, ratio := range ratios {
+		s := new(big.Int).Mul(n, big.NewInt(int64(ratio)))
+		shares[i] = s.Quo(s, total)
+		rest.Sub(rest, s)
+	}
+	one := big.NewInt(1)
+	for i := 0; rest.Sign() > 0; i++ {
+		if ratios[i] > 0 {
+			shares[i].Add(shares[i], one)
+			rest.Sub(rest, one)
+		}
+	}
+
+	parts := make([]Amount, len(ratios))
+	for i, s := range shares {
+		if neg {
+			s.Neg(s)
+		}
+		x := new(big.Rat).SetInt(s)
+		parts[i] = Amount{amount: x.Mul(x, unit), currency: a.currency}
+	}
+	return parts
+}
+
+// Split splits a into n parts that differ by at most one rounding increment
+// of kind k and add up to a rounded according to k. It is equivalent to
+// calling Allocate with n equal ratios.
+func (a Amount) Split(k Kind, n int) []Amount {
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return a.Allocate(k, ratios...)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,166 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestAmountAccessors(t *testing.T) {
+	testCases := []struct {
+		a       Amount
+		decimal string
+		scale   uint
+		sign    int
+		i, frac int64
+		float   float64
+		err     error
+	}{
+		{USD.Amount(12), "12", 0, 1, 12, 0, 12, nil},
+		{USD.Amount(-12.05), "-12.05", 2, -1, -12, -5, -12.05, nil},
+		{USD.Amount(0.1), "0.1", 1, 1, 0, 1, 0.1, nil},
+		{USD.Amount(uint8(0)), "0", 0, 0, 0, 0, 0, nil},
+		{USD.Amount("1234.500"), "1234.500", 3, 1, 1234, 500, 1234.5, nil},
+		{USD.Amount(big.NewRat(-1, 8)), "-0.125", 3, -1, 0, -125, -0.125, nil},
+		{USD.Amount(big.NewInt(42)), "42", 0, 1, 42, 0, 42, nil},
+		{USD.Amount(big.NewFloat(2.5)), "2.5", 1, 1, 2, 5, 2.5, nil},
+		{USD.Amount(math.NaN()), "NaN", 0, 0, 0, 0, 0, errNotNumber},
+		{USD.Amount(math.Inf(-1)), "-Inf", 0, 0, 0, 0, 0, errNotNumber},
+		{USD.Amount(nil), "NaN", 0, 0, 0, 0, 0, errNotNumber},
+		{USD.Amount("x"), "NaN", 0, 0, 0, 0, 0, errNotNumber},
+	}
+	for _, tc := range testCases {
+		a := tc.a
+		if got := a.Decimal(); got != tc.decimal {
+			t.Errorf("%v: Decimal: got %q; want %q", tc.decimal, got, tc.decimal)
+		}
+		if got := a.Scale(); got != tc.scale {
+			t.Errorf("%v: Scale: got %d; want %d", tc.decimal, got, tc.scale)
+		}
+		if got := a.Sign(); got != tc.sign {
+			t.Errorf("%v: Sign: got %d; want %d", tc.decimal, got, tc.sign)
+		}
+		if got, err := a.Int(); got != tc.i || err != tc.err {
+			t.Errorf("%v: Int: got %d, %v; want %d, %v", tc.decimal, got, err, tc.i, tc.err)
+		}
+		if got, err := a.Fraction(); got != tc.frac || err != tc.err {
+			t.Errorf("%v: Fraction: got %d, %v; want %d, %v", tc.decimal, got, err, tc.frac, tc.err)
+		}
+		if got, err := a.Float(); got != tc.float || err != tc.err {
+			t.Errorf("%v: Float: got %v, %v; want %v, %v", tc.decimal, got, err, tc.float, tc.err)
+		}
+		if r := a.Rat(); (r == nil) != (tc.err != nil) {
+			t.Errorf("%v: Rat: got %v", tc.decimal, r)
+		}
+	}
+	if _, err := USD.Amount("1e30").Int(); err != errRange {
+		t.Errorf("Int: got %v; want %v", err, errRange)
+	}
+	if _, err := USD.Amount(new(big.Rat).SetFrac(pow10(400), big.NewInt(1))).Float(); err != errRange {
+		t.Errorf("Float: got %v; want %v", err, errRange)
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	sum, err := USD.Amount(0.1).Add(USD.Amount(0.2))
+	if err != nil || sum.Decimal() != "0.3" {
+		t.Errorf("Add: got %v, %v; want 0.3", sum.Decimal(), err)
+	}
+	diff, err := USD.Amount("10").Sub(USD.Amount(big.NewRat(1, 4)))
+	if err != nil || diff.Decimal() != "9.75" || diff.Currency() != USD {
+		t.Errorf("Sub: got %v %v, %v; want USD 9.75", diff.Currency(), diff.Decimal(), err)
+	}
+	if _, err := USD.Amount(1).Add(EUR.Amount(1)); err != errMismatch {
+		t.Errorf("Add: got %v; want %v", err, errMismatch)
+	}
+	if _, err := USD.Amount(1).Sub(USD.Amount(math.NaN())); err != errNotNumber {
+		t.Errorf("Sub: got %v; want %v", err, errNotNumber)
+	}
+	if got := USD.Amount(19.99).Mul(big.NewRat(19, 100)).Decimal(); got != "3.7981" {
+		t.Errorf("Mul: got %v; want 3.7981", got)
+	}
+	if got := USD.Amount(math.NaN()).Mul(big.NewRat(1, 2)).Decimal(); got != "NaN" {
+		t.Errorf("Mul: got %v; want NaN", got)
+	}
+}
+
+func TestAmountRound(t *testing.T) {
+	testCases := []struct {
+		a    Amount
+		k    Kind
+		want string
+	}{
+		{USD.Amount(3.7981), Standard, "3.8"},
+		{USD.Amount(0.125), Standard, "0.13"},
+		{USD.Amount(-0.125), Standard, "-0.13"},
+		{JPY.Amount(12.5), Standard, "13"},
+		{CHF.Amount(1.024), Cash, "1"},
+		{CHF.Amount(1.025), Cash, "1.05"},
+		{DKK.Amount(0.25), Cash, "0.5"},
+		{USD.Amount(big.NewRat(2, 3)), Accounting, "0.67"},
+		{USD.Amount(math.Inf(1)), Standard, "NaN"},
+	}
+	for _, tc := range testCases {
+		if got := tc.a.Round(tc.k).Decimal(); got != tc.want {
+			t.Errorf("%v.Round(%v): got %v; want %v", tc.a.Decimal(), tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	testCases := []struct {
+		a      Amount
+		k      Kind
+		ratios []int
+		want   []string
+	}{
+		{USD.Amount(0.05), Standard, []int{3, 7}, []string{"0.02", "0.03"}},
+		{USD.Amount(100), Standard, []int{1, 1, 1}, []string{"33.34", "33.33", "33.33"}},
+		{USD.Amount(-100), Standard, []int{1, 1, 1}, []string{"-33.34", "-33.33", "-33.33"}},
+		{USD.Amount(10.005), Standard, []int{1, 1}, []string{"5.01", "5"}},
+		{USD.Amount(1), Standard, []int{0, 1, 1}, []string{"0", "0.5", "0.5"}},
+		{USD.Amount(0.01), Standard, []int{0, 1, 1}, []string{"0", "0.01", "0"}},
+		{CHF.Amount(10), Cash, []int{1, 1, 1}, []string{"3.35", "3.35", "3.3"}},
+		{JPY.Amount(1000), Standard, []int{1, 2}, []string{"334", "666"}},
+	}
+	for _, tc := range testCases {
+		parts := tc.a.Allocate(tc.k, tc.ratios...)
+		var got []string
+		for _, p := range parts {
+			if p.Currency() != tc.a.Currency() {
+				t.Errorf("currency: got %v; want %v", p.Currency(), tc.a.Currency())
+			}
+			got = append(got, p.Decimal())
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%v.Allocate(%v): got %v; want %v", tc.a.Decimal(), tc.ratios, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%v.Allocate(%v): got %v; want %v", tc.a.Decimal(), tc.ratios, got, tc.want)
+				break
+			}
+		}
+	}
+	if got := USD.Amount(math.NaN()).Allocate(Standard, 1, 1); got != nil {
+		t.Errorf("Allocate(NaN): got %v; want nil", got)
+	}
+	if got := len(EUR.Amount(1).Split(Standard, 3)); got != 3 {
+		t.Errorf("Split: got %d parts; want 3", got)
+	}
+	for _, ratios := range [][]int{{}, {0, 0}, {1, -1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Allocate(%v): did not panic", ratios)
+				}
+			}()
+			USD.Amount(1).Allocate(Standard, ratios...)
+		}()
+	}
+}
@@ -19,8 +19,6 @@ import (
 )
 
 // TODO:
-// - language-specific currency names.
-// - currency formatting.
 // - currency information per region
 // - register currency code (there are no private use area)
 
@@ -29,7 +27,7 @@ import (
 // Kind determines the rounding and rendering properties of a currency value.
 type Kind struct {
 	rounding rounding
-	// TODO: formatting type: standard, accounting. See CLDR.
+	style    style
 }
 
 type rounding byte
@@ -39,6 +37,16 @@ const (
 	cash
 )
 
+// A style selects the CLDR currency pattern used for formatting amounts.
+type style byte
+
+const (
+	standardStyle style = iota
+	accountingStyle
+
+	numStyles
+)
+
 var (
 	// Standard defines standard rounding and formatting for currencies.
 	Standard Kind = Kind{rounding: standard}
@@ -47,13 +55,21 @@ var (
 	Cash Kind = Kind{rounding: cash}
 
 	// Accounting defines rounding and formatting standards for accounting.
-	Accounting Kind = Kind{rounding: standard}
+	// Depending on the language, negative amounts are formatted in
+	// parentheses.
+	Accounting Kind = Kind{rounding: standard, style: accountingStyle}
 )
 
 // Rounding reports the rounding characteristics for the given currency, where
 // scale is the number of fractional decimals and increment is the number of
 // units in terms of 10^(-scale) to which to round to.
 func (k Kind) Rounding(cur Unit) (scale, increment int) {
+	if d := cur.custom(); d != nil {
+		if k.rounding == cash {
+			return d.CashScale, d.CashIncrement
+		}
+		return d.Scale, d.Increment
+	}
 	info := currency.Elem(int(cur.index))[3]
 	switch k.rounding {
 	case standard:
@@ -64,20 +80,27 @@ func (k Kind) Rounding(cur Unit) (scale, increment int) {
 	return int(roundings[info].scale), int(roundings[info].increment)
 }
 
-// Unit is an ISO 4217 currency designator.
+// Unit is an ISO 4217 currency designator or a custom currency unit created
+// with Register.
 type Unit struct {
 	index uint16
 }
 
-// String returns the ISO code of u.
+// String returns the ISO code of u or the code of a custom unit.
 func (u Unit) String() string {
 	if u.index == 0 {
 		return "XXX"
 	}
+	if d := u.custom(); d != nil {
+		return d.Code
+	}
 	return currency.Elem(int(u.index))[:3]
 }
 
-// Amount creates an Amount for the given currency unit and amount.
+// Amount creates an Amount for the given currency unit and amount. The amount
+// may be of any of the builtin numeric types, a *big.Int, *big.Float, or
+// *big.Rat, or a decimal string, such as "1234.50". Arbitrary-precision
+// amounts are formatted without loss of precision.
 func (u Unit) Amount(amount interface{}) Amount {
 	// TODO: verify amount is a supported number type
 	return Amount{amount: amount, currency: u}
@@ -105,6 +128,23 @@ func ParseISO(s string) (Unit, error) {
 	return Unit{}, errValue
 }
 
+// Parse parses an ISO 4217 currency code or the code of a custom unit created
+// with Register. It returns an error if s is not well-formed or not a
+// recognized currency code.
+func Parse(s string) (Unit, error) {
+	u, err := ParseISO(s)
+	if err == nil {
+		return u, nil
+	}
+	if code, ok := normalizeCode(s); ok {
+		if u, ok := lookupCustom(code); ok {
+			return u, nil
+		}
+		return Unit{}, errValue
+	}
+	return Unit{}, err
+}
+
 // MustParseISO is like ParseISO, but panics if the given currency unit
 // cannot be parsed. It simplifies safe initialization of Unit values.
 func MustParseISO(s string) Unit {
@@ -0,0 +1,210 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// A Definition describes a custom currency unit, such as loyalty points, a
+// crypto asset, or a currency used for testing.
+type Definition struct {
+	// Code identifies the unit. It consists of 3 to 8 ASCII letters and
+	// digits and may not be an ISO 4217 code. Codes are case-insensitive and
+	// are converted to upper case.
+	Code string
+
+	// Scale is the number of fraction digits of amounts of the unit and
+	// Increment is the increment, in units of 10^-Scale, to which amounts are
+	// rounded. An Increment of 0 is equivalent to 1.
+	Scale, Increment int
+
+	// CashScale and CashIncrement define the rounding of the unit for Cash.
+	// The standard rounding is used if both are 0.
+	CashScale, CashIncrement int
+
+	// Symbol is the symbol of the unit. It defaults to Code. NarrowSymbol
+	// defaults to Symbol.
+	Symbol, NarrowSymbol string
+
+	// Name is the display name of the unit. It defaults to Code.
+	// PluralNames optionally defines the names for amounts of the unit per
+	// plural form, as in "1 point" and "2 points". Names for forms that are
+	// not defined fall back to the name for plural.Other and then to Name.
+	// The names are used for all languages.
+	Name        string
+	PluralNames map[plural.Form]string
+
+	// Regions lists the regions in which the unit is used. Query reports the
+	// unit for these regions as not being legal tender.
+	Regions []language.Region
+}
+
+// customBase is the index of the first custom unit. Indexes of custom units
+// must not overlap with those of ISO units or with nonTenderBit.
+const customBase = 0x4000
+
+var (
+	errCustomCode      = errors.New("currency: custom code is not well-formed")
+	errCustomISO       = errors.New("currency: custom code is an ISO 4217 code")
+	errCustomDuplicate = errors.New("currency: custom code is already registered")
+	errCustomRounding  = errors.New("currency: invalid rounding for custom unit")
+	errCustomFull      = errors.New("currency: too many custom units")
+)
+
+// registry holds the registered custom units.
+var registry struct {
+	sync.RWMutex
+	units  []*Definition
+	codes  map[string]Unit
+	region []regionInfo // regionData and custom entries, sorted by region
+}
+
+// Register registers the custom currency unit defined by d and returns it. The
+// returned unit can be used like any ISO unit for rounding, formatting, and
+// queries. Register returns an error if the code of d is not well-formed, is
+// an ISO 4217 code, or is already registered.
+func Register(d Definition) (Unit, error) {
+	code, ok := normalizeCode(d.Code)
+	if !ok {
+		return Unit{}, errCustomCode
+	}
+	if len(code) == 3 && currency.Index([]byte(code)) >= 0 {
+		return Unit{}, errCustomISO
+	}
+	if !validRounding(d.Scale, d.Increment) || !validRounding(d.CashScale, d.CashIncrement) {
+		return Unit{}, errCustomRounding
+	}
+	d.Code = code
+	if d.Increment == 0 {
+		d.Increment = 1
+	}
+	if d.CashScale == 0 && d.CashIncrement == 0 {
+		d.CashScale, d.CashIncrement = d.Scale, d.Increment
+	} else if d.CashIncrement == 0 {
+		d.CashIncrement = 1
+	}
+	if d.Symbol == "" {
+		d.Symbol = code
+	}
+	if d.NarrowSymbol == "" {
+		d.NarrowSymbol = d.Symbol
+	}
+	if d.Name == "" {
+		d.Name = code
+	}
+	names := make(map[plural.Form]string, len(d.PluralNames))
+	for f, s := range d.PluralNames {
+		names[f] = s
+	}
+	d.PluralNames = names
+	d.Regions = append([]language.Region(nil), d.Regions...)
+
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.codes[code]; ok {
+		return Unit{}, errCustomDuplicate
+	}
+	if len(registry.units) >= nonTenderBit-customBase {
+		return Unit{}, errCustomFull
+	}
+	u := Unit{uint16(customBase + len(registry.units))}
+	if registry.codes == nil {
+		registry.codes = map[string]Unit{}
+	}
+	registry.codes[code] = u
+	registry.units = append(registry.units, &d)
+
+	// Copy the region data so that running queries are not affected.
+	region := registry.region
+	if region == nil {
+		region = regionData
+	}
+	region = append([]regionInfo(nil), region...)
+	for _, r := range d.Regions {
+		if x := regionToCode(r); x != 0 {
+			region = append(region, regionInfo{region: x, code: u.index | nonTenderBit})
+		}
+	}
+	sort.SliceStable(region, func(i, j int) bool {
+		return region[i].region < region[j].region
+	})
+	registry.region = region
+	return u, nil
+}
+
+// normalizeCode converts s to upper case and reports whether it is a
+// well-formed custom code.
+func normalizeCode(s string) (string, bool) {
+	if len(s) < 3 || len(s) > 8 {
+		return "", false
+	}
+	b := []byte(s)
+	for i, c := range b {
+		switch {
+		case 'a' <= c && c <= 'z':
+			b[i] = c - 'a' + 'A'
+		case 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		default:
+			return "", false
+		}
+	}
+	return string(b), true
+}
+
+func validRounding(scale, increment int) bool {
+	return 0 <= scale && scale <= 18 && 0 <= increment && increment <= 1<<31-1
+}
+
+// IsCustom reports whether u is a custom unit created with Register rather
+// than an ISO 4217 unit.
+func (u Unit) IsCustom() bool {
+	return u.index >= customBase
+}
+
+// custom returns the definition of u or nil if u is not a custom unit.
+func (u Unit) custom() *Definition {
+	if u.index < customBase {
+		return nil
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	return registry.units[u.index-customBase]
+}
+
+// lookupCustom returns the custom unit registered for code s, which must be in
+// upper case.
+func lookupCustom(s string) (Unit, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	u, ok := registry.codes[s]
+	return u, ok
+}
+
+// regions returns the region data for ISO and custom units.
+func regions() []regionInfo {
+	registry.RLock()
+	defer registry.RUnlock()
+	if registry.region == nil {
+		return regionData
+	}
+	return registry.region
+}
+
+// pluralName returns the name of custom unit d for plural form form.
+func (d *Definition) pluralName(form plural.Form) string {
+	if s, ok := d.PluralNames[form]; ok {
+		return s
+	}
+	if s, ok := d.PluralNames[plural.Other]; ok {
+		return s
+	}
+	return d.Name
+}
@@ -0,0 +1,160 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func mustRegister(d Definition) Unit {
+	u, err := Register(d)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+var (
+	testPoints = mustRegister(Definition{
+		Code:   "pts",
+		Symbol: "P",
+		Name:   "Points",
+		PluralNames: map[plural.Form]string{
+			plural.One:   "point",
+			plural.Other: "points",
+		},
+		Regions: []language.Region{language.MustParseRegion("NL")},
+	})
+	testToken = mustRegister(Definition{
+		Code:          "TOKEN",
+		Scale:         4,
+		Increment:     5,
+		CashScale:     1,
+		CashIncrement: 0,
+	})
+)
+
+func TestRegisterErrors(t *testing.T) {
+	testCases := []struct {
+		d   Definition
+		err error
+	}{
+		{Definition{Code: "AB"}, errCustomCode},
+		{Definition{Code: "ABCDEFGHI"}, errCustomCode},
+		{Definition{Code: "AB-C"}, errCustomCode},
+		{Definition{Code: "usd"}, errCustomISO},
+		{Definition{Code: "PTS"}, errCustomDuplicate},
+		{Definition{Code: "NEG1", Scale: -1}, errCustomRounding},
+		{Definition{Code: "NEG2", CashIncrement: -5}, errCustomRounding},
+	}
+	for _, tc := range testCases {
+		if _, err := Register(tc.d); err != tc.err {
+			t.Errorf("Register(%+v): got %v; want %v", tc.d, err, tc.err)
+		}
+	}
+}
+
+func TestCustom(t *testing.T) {
+	if !testPoints.IsCustom() || USD.IsCustom() || XXX.IsCustom() {
+		t.Errorf("IsCustom: got %v, %v, %v; want true, false, false",
+			testPoints.IsCustom(), USD.IsCustom(), XXX.IsCustom())
+	}
+	if got := testPoints.String(); got != "PTS" {
+		t.Errorf("String: got %q; want %q", got, "PTS")
+	}
+	for _, s := range []string{"PTS", "pts", "Token"} {
+		u, err := Parse(s)
+		if err != nil || !u.IsCustom() {
+			t.Errorf("Parse(%q): got %v, %v; want custom unit", s, u, err)
+		}
+	}
+	if u, err := Parse("eur"); err != nil || u != EUR {
+		t.Errorf("Parse(eur): got %v, %v; want EUR", u, err)
+	}
+	if _, err := Parse("NOPE"); err != errValue {
+		t.Errorf("Parse(NOPE): got %v; want %v", err, errValue)
+	}
+	if _, err := ParseISO("PTS"); err == nil {
+		t.Errorf("ParseISO(PTS): unexpected success")
+	}
+}
+
+func TestCustomRounding(t *testing.T) {
+	testCases := []struct {
+		kind      Kind
+		unit      Unit
+		scale     int
+		increment int
+	}{
+		{Standard, testPoints, 0, 1},
+		{Cash, testPoints, 0, 1},
+		{Standard, testToken, 4, 5},
+		{Cash, testToken, 1, 1},
+	}
+	for _, tc := range testCases {
+		scale, inc := tc.kind.Rounding(tc.unit)
+		if scale != tc.scale || inc != tc.increment {
+			t.Errorf("%v.Rounding(%v): got %d, %d; want %d, %d",
+				tc.kind, tc.unit, scale, inc, tc.scale, tc.increment)
+		}
+	}
+}
+
+func TestCustomFormatting(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		x    interface{}
+		want string
+	}{
+		{"en", ISO(testPoints.Amount(1234.5)), "PTS\u00a01,235"},
+		{"en", Symbol(testPoints.Amount(1234.5)), "P\u00a01,235"},
+		{"de", Symbol(testPoints.Amount(1234.5)), "1.235\u00a0P"},
+		{"en", NarrowSymbol(testPoints), "P"},
+		{"en", Name(testPoints), "Points"},
+		{"en", Name(testPoints.Amount(1)), "1 point"},
+		{"en", Name(testPoints.Amount(3)), "3 points"},
+		{"en", ISO(testToken.Amount(1.23456)), "TOKEN\u00a01.2345"},
+		{"en", ISO.Kind(Cash)(testToken.Amount(1.23456)), "TOKEN\u00a01.2"},
+		{"en", Symbol(testToken), "TOKEN"},
+	}
+	for i, tc := range testCases {
+		p := message.NewPrinter(language.MustParse(tc.tag))
+		if got := p.Sprint(tc.x); got != tc.want {
+			t.Errorf("%d: got %q; want %q", i, got, tc.want)
+		}
+	}
+}
+
+func TestCustomQuery(t *testing.T) {
+	nl := language.MustParseRegion("NL")
+	var got []Unit
+	for it := Query(Region(nl), NonTender); it.Next(); {
+		if it.Region() != nl {
+			t.Errorf("got region %v; want %v", it.Region(), nl)
+		}
+		if it.Unit() == testPoints && it.IsTender() {
+			t.Errorf("%v is tender; want non-tender", testPoints)
+		}
+		got = append(got, it.Unit())
+	}
+	if fmt.Sprint(got[len(got)-1]) != "PTS" {
+		t.Errorf("Query(NL, NonTender): got %v; want PTS as last unit", got)
+	}
+	for it := Query(Region(nl)); it.Next(); {
+		if it.Unit().IsCustom() {
+			t.Errorf("Query(NL): got custom unit %v", it.Unit())
+		}
+	}
+	for it := Query(); it.Next(); {
+		if it.Unit().IsCustom() {
+			t.Errorf("Query(): got custom unit %v", it.Unit())
+		}
+	}
+}
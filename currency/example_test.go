@@ -6,9 +6,13 @@ package currency_test
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"golang.org/x/text/currency"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
 func ExampleQuery() {
@@ -25,3 +29,110 @@ func ExampleQuery() {
 	// GIP is used in GI since: 1713-01-01
 	// USD is used in US since: 1792-01-01
 }
+
+func ExampleFormatter_Kind() {
+	amounts := []struct {
+		lang   string
+		amount currency.Amount
+	}{
+		{"en", currency.USD.Amount(-1234.5)},
+		{"de-CH", currency.CHF.Amount(-1234.5)},
+		{"ja", currency.JPY.Amount(-1234.5)},
+	}
+	accounting := currency.Symbol.Kind(currency.Accounting)
+	for _, a := range amounts {
+		p := message.NewPrinter(language.MustParse(a.lang))
+		p.Printf("%-5s %v | %v\n", a.lang, currency.Symbol(a.amount), accounting(a.amount))
+	}
+	// Output:
+	// en    -$1,234.50 | ($1,234.50)
+	// de-CH CHF-1’234.50 | CHF-1’234.50
+	// ja    -￥1,235 | (￥1,235)
+}
+
+func ExampleNames() {
+	n := currency.Names(language.English)
+	fmt.Println(n.Name(currency.EUR))
+
+	p := message.NewPrinter(language.English)
+	p.Println(currency.Name(currency.EUR.Amount(1)))
+	p.Println(currency.Name(currency.JPY.Amount(1)))
+
+	p = message.NewPrinter(language.French)
+	p.Println(currency.Name(currency.EUR.Amount(1.5)))
+	p.Println(currency.Name(currency.EUR.Amount(2)))
+
+	// Output:
+	// Euro
+	// 1.00 euros
+	// 1 Japanese yen
+	// 1,50 euro
+	// 2,00 euros
+}
+
+func ExampleAmount_Allocate() {
+	total := currency.USD.Amount(100)
+	p := message.NewPrinter(language.English)
+	for _, part := range total.Allocate(currency.Standard, 1, 1, 1) {
+		p.Println(currency.Symbol(part))
+	}
+
+	tax := total.Mul(big.NewRat(19, 100))
+	sum, _ := total.Add(tax)
+	p.Println(currency.Symbol(sum.Round(currency.Standard)))
+
+	// Output:
+	// $33.34
+	// $33.33
+	// $33.33
+	// $119.00
+}
+
+func ExampleRegister() {
+	miles, err := currency.Register(currency.Definition{
+		Code:   "miles",
+		Symbol: "✈",
+		Name:   "Air Miles",
+		PluralNames: map[plural.Form]string{
+			plural.One:   "air mile",
+			plural.Other: "air miles",
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(miles, miles.IsCustom())
+
+	p := message.NewPrinter(language.English)
+	p.Println(currency.Symbol(miles.Amount(1500)))
+	p.Println(currency.Name(miles.Amount(1)))
+	p.Println(currency.Name(miles.Amount(1500)))
+
+	// Output:
+	// MILES true
+	// ✈1,500
+	// 1 air mile
+	// 1,500 air miles
+}
+
+func ExampleParseAmount() {
+	for _, s := range []string{"1.234,50 €", "-12 US-Dollar", "CHF 3"} {
+		a, err := currency.ParseAmount(language.German, s)
+		fmt.Println(a.Currency(), a.Decimal(), err)
+	}
+
+	// "$" denotes several currencies; Strict requires a region hint.
+	_, err := currency.ParseAmount(language.English, "$5", currency.Strict)
+	fmt.Println(err)
+	a, _ := currency.ParseAmount(language.English, "$5", currency.Strict,
+		currency.RegionHint(language.MustParseRegion("CA")))
+	fmt.Println(a.Currency(), a.Decimal())
+
+	// Output:
+	// EUR 1234.50 <nil>
+	// USD -12 <nil>
+	// CHF 3 <nil>
+	// currency: ambiguous currency symbol
+	// CAD 5
+}
@@ -7,12 +7,13 @@ package currency
 import (
 	"fmt"
 	"sort"
+	"sync"
 
 	"golang.org/x/text/internal/format"
 	"golang.org/x/text/internal/language/compact"
-	"golang.org/x/text/internal/number"
-
+	inumber "golang.org/x/text/internal/number"
 	"golang.org/x/text/language"
+	"golang.org/x/text/number"
 )
 
 // Amount is an amount-currency unit pair.
@@ -24,18 +25,6 @@ type Amount struct {
 // Currency reports the currency unit of this amount.
 func (a Amount) Currency() Unit { return a.currency }
 
-// TODO: based on decimal type, but may make sense to customize a bit.
-// func (a Amount) Decimal()
-// func (a Amount) Int() (int64, error)
-// func (a Amount) Fraction() (int64, error)
-// func (a Amount) Rat() *big.Rat
-// func (a Amount) Float() (float64, error)
-// func (a Amount) Scale() uint
-// func (a Amount) Precision() uint
-// func (a Amount) Sign() int
-//
-// Add/Sub/Div/Mul/Round.
-
 // Format implements fmt.Formatter. It accepts format.State for
 // language-specific rendering.
 func (a Amount) Format(s fmt.State, verb rune) {
@@ -47,6 +36,17 @@ func (a Amount) Format(s fmt.State, verb rune) {
 	v.Format(s, verb)
 }
 
+// FormatToParts formats a for language t and returns the result along with
+// its parts. See number.Formatter.FormatToParts for details.
+func (a Amount) FormatToParts(t language.Tag) (string, []number.Part) {
+	v := formattedValue{
+		currency: a.currency,
+		amount:   a.amount,
+		format:   defaultFormat,
+	}
+	return v.FormatToParts(t)
+}
+
 // formattedValue is currency amount or unit that implements language-sensitive
 // formatting.
 type formattedValue struct {
@@ -59,11 +59,29 @@ type formattedValue struct {
 // language-specific rendering.
 func (v formattedValue) Format(s fmt.State, verb rune) {
 	var tag language.Tag
-	var lang compact.ID
 	if state, ok := s.(format.State); ok {
 		tag = state.Language()
-		lang, _ = compact.RegionalID(compact.Tag(tag))
 	}
+	s.Write(v.appendFormat(nil, nil, tag))
+}
+
+// FormatToParts formats v for language t and returns the result along with
+// its parts. The currency symbol is reported as a number.CurrencyPart. See
+// number.Formatter.FormatToParts for details.
+func (v formattedValue) FormatToParts(t language.Tag) (string, []number.Part) {
+	var parts []inumber.Part
+	b := v.appendFormat(nil, &parts, t)
+	p := make([]number.Part, len(parts))
+	for i, x := range parts {
+		p[i] = number.Part{Kind: number.PartKind(x.Kind), Start: x.Start, End: x.End}
+	}
+	return string(b), p
+}
+
+// appendFormat appends v formatted for language tag to dst. If parts is not
+// nil, the parts of the formatted amount are appended to it.
+func (v formattedValue) appendFormat(dst []byte, parts *[]inumber.Part, tag language.Tag) []byte {
+	lang, _ := compact.RegionalID(compact.Tag(tag))
 
 	// Get the options. Use DefaultFormat if not present.
 	opt := v.format
@@ -75,26 +93,105 @@ func (v formattedValue) Format(s fmt.State, verb rune) {
 		cur = opt.currency
 	}
 
-	sym := opt.symbol(lang, cur)
-	if v.amount != nil {
-		var f number.Formatter
-		f.InitDecimal(tag)
+	if opt.name {
+		return appendName(dst, parts, tag, opt, cur, v.amount)
+	}
+	symbol := opt.symbol(lang, cur)
+	if v.amount == nil {
+		start := len(dst)
+		dst = append(dst, symbol...)
+		if parts != nil && start < len(dst) {
+			*parts = append(*parts, inumber.Part{Kind: inumber.CurrencyPart, Start: start, End: len(dst)})
+		}
+		return dst
+	}
+	var f inumber.Formatter
+	f.InitDecimal(tag)
+	cf := lookupFormat(tag, cur, opt.kind.style)
+	f.Pattern = *cf.pattern
+	f.Currency = symbol
+	f.CurrencyDecimal = cf.decimal
+	f.CurrencyGroup = cf.group
+	opt.setRounding(&f, cur)
+	f.Parts = parts
+	return f.Append(dst, v.amount)
+}
 
-		scale, increment := opt.kind.Rounding(cur)
-		f.RoundingContext.SetScale(scale)
-		f.RoundingContext.Increment = uint32(increment)
-		f.RoundingContext.IncrementScale = uint8(scale)
-		f.RoundingContext.Mode = number.ToNearestAway
+// setRounding sets the rounding of f to that of currency c.
+func (o *options) setRounding(f *inumber.Formatter, c Unit) {
+	scale, increment := o.kind.Rounding(c)
+	f.RoundingContext.SetScale(scale)
+	f.RoundingContext.Increment = uint32(increment)
+	f.RoundingContext.IncrementScale = uint8(scale)
+	f.RoundingContext.Mode = o.mode
+}
 
-		d := f.Append(nil, v.amount)
+// A currencyFormat holds the pattern and separators for formatting amounts of
+// a currency in a language.
+type currencyFormat struct {
+	pattern        *inumber.Pattern
+	decimal, group string
+}
 
-		fmt.Fprint(s, sym, " ", string(d))
-	} else {
-		fmt.Fprint(s, sym)
+type formatKey struct {
+	tag   language.Tag
+	cur   Unit
+	style style
+}
+
+var formatCache sync.Map // formatKey -> *currencyFormat
+
+// lookupFormat returns the format for amounts of currency cur in language t,
+// inheriting missing patterns and overrides from the parent languages.
+func lookupFormat(t language.Tag, cur Unit, s style) *currencyFormat {
+	b, sc, r := t.Raw()
+	key, _ := language.Compose(b, sc, r)
+	fk := formatKey{key, cur, s}
+	if f, ok := formatCache.Load(fk); ok {
+		return f.(*currencyFormat)
+	}
+	var o currencyOverride
+	pattern := ""
+	code := cur.String()
+	for p := key; ; p = p.Parent() {
+		id := p.String()
+		for _, c := range []string{code, ""} {
+			x := currencyOverrides[id][c]
+			if o.pattern == "" {
+				o.pattern = x.pattern
+			}
+			if o.decimal == "" {
+				o.decimal = x.decimal
+			}
+			if o.group == "" {
+				o.group = x.group
+			}
+		}
+		if pattern == "" {
+			pattern = currencyPatterns[id][s]
+		}
+		if p.IsRoot() {
+			break
+		}
+	}
+	if o.pattern != "" {
+		pattern = o.pattern
 	}
+	pat, err := inumber.ParsePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	f := &currencyFormat{pat, o.decimal, o.group}
+	formatCache.Store(fk, f)
+	return f
 }
 
 // Formatter decorates a given number, Unit or Amount with formatting options.
+//
+// Amounts are formatted using the CLDR currency patterns of the language,
+// which determine the placement of the currency symbol and the sign. A space
+// is inserted between the symbol and the number if the symbol would otherwise
+// run into the digits, as in "USD 1.00".
 type Formatter func(amount interface{}) formattedValue
 
 // func (f Formatter) Options(opts ...Option) Formatter
@@ -116,11 +213,18 @@ func (f Formatter) Default(currency Unit) Formatter {
 	return f.adjust(func(o *options) { o.currency = currency })
 }
 
-// Kind sets the kind of the underlying currency unit.
+// Kind sets the kind of the underlying currency unit. The kind determines the
+// rounding of amounts and, for Accounting, the pattern for formatting them.
 func (f Formatter) Kind(k Kind) Formatter {
 	return f.adjust(func(o *options) { o.kind = k })
 }
 
+// RoundingMode sets the mode for rounding amounts to the scale and increment
+// of the currency. The default is number.ToNearestAway.
+func (f Formatter) RoundingMode(mode number.Rounding) Formatter {
+	return f.adjust(func(o *options) { o.mode = inumber.RoundingMode(mode) })
+}
+
 var defaultFormat *options = ISO(dummy).format
 
 var (
@@ -133,17 +237,22 @@ var (
 	// Use ISO code as symbol.
 	ISO Formatter = Formatter(formISO)
 
-	// TODO:
-	// // Use full name as symbol.
-	// Name Formatter
+	// Use the full name of the currency, such as "US dollars", instead of a
+	// symbol. The name of an amount depends on the plural form of the number.
+	Name Formatter = Formatter(formName)
 )
 
 // options configures rendering and rounding options for an Amount.
 type options struct {
 	currency Unit
 	kind     Kind
+	mode     inumber.RoundingMode
 
 	symbol func(compactIndex compact.ID, c Unit) string
+
+	// name indicates that amounts are formatted with the name of the
+	// currency rather than with a symbol.
+	name bool
 }
 
 func (o *options) format(amount interface{}) formattedValue {
@@ -171,9 +280,10 @@ func (o *options) format(amount interface{}) formattedValue {
 }
 
 var (
-	optISO    = options{symbol: lookupISO}
-	optSymbol = options{symbol: lookupSymbol}
-	optNarrow = options{symbol: lookupNarrow}
+	optISO    = options{symbol: lookupISO, mode: inumber.ToNearestAway}
+	optSymbol = options{symbol: lookupSymbol, mode: inumber.ToNearestAway}
+	optNarrow = options{symbol: lookupNarrow, mode: inumber.ToNearestAway}
+	optName   = options{symbol: lookupISO, name: true, mode: inumber.ToNearestAway}
 )
 
 // These need to be functions, rather than curried methods, as curried methods
@@ -181,10 +291,22 @@ var (
 func formISO(x interface{}) formattedValue    { return optISO.format(x) }
 func formSymbol(x interface{}) formattedValue { return optSymbol.format(x) }
 func formNarrow(x interface{}) formattedValue { return optNarrow.format(x) }
+func formName(x interface{}) formattedValue   { return optName.format(x) }
 
-func lookupISO(x compact.ID, c Unit) string    { return c.String() }
-func lookupSymbol(x compact.ID, c Unit) string { return normalSymbol.lookup(x, c) }
-func lookupNarrow(x compact.ID, c Unit) string { return narrowSymbol.lookup(x, c) }
+func lookupISO(x compact.ID, c Unit) string { return c.String() }
+func lookupSymbol(x compact.ID, c Unit) string {
+	if d := c.custom(); d != nil {
+		return d.Symbol
+	}
+	return normalSymbol.lookup(x, c)
+}
+
+func lookupNarrow(x compact.ID, c Unit) string {
+	if d := c.custom(); d != nil {
+		return d.NarrowSymbol
+	}
+	return narrowSymbol.lookup(x, c)
+}
 
 type symbolIndex struct {
 	index []uint16 // position corresponds with compact index of language.
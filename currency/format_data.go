@@ -0,0 +1,65 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+// currencyPatterns holds the CLDR standard and accounting currency patterns
+// per language for the "latn" numbering system, derived from the CLDR 32
+// "currencyFormats-numberSystem-latn" formats. Patterns of languages that are
+// not listed are inherited from the parent language.
+//
+// The currency sign ¤ is replaced with the symbol, ISO code, or narrow symbol
+// of the currency. The number of fraction digits is determined by the currency
+// rather than by the pattern.
+var currencyPatterns = map[string][numStyles]string{
+	"und":     {"¤\u00a0#,##0.00", "¤\u00a0#,##0.00"},
+	"cs":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"da":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"de":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"de-AT":   {"¤\u00a0#,##0.00", "¤\u00a0#,##0.00"},
+	"de-CH":   {"¤\u00a0#,##0.00;¤-#,##0.00", "¤\u00a0#,##0.00;¤-#,##0.00"},
+	"de-LI":   {"¤\u00a0#,##0.00", "¤\u00a0#,##0.00"},
+	"en":      {"¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	"en-150":  {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"en-IN":   {"¤#,##,##0.00", "¤#,##,##0.00;(¤#,##,##0.00)"},
+	"es":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"es-419":  {"¤#,##0.00", "¤#,##0.00"},
+	"fi":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"fr":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤;(#,##0.00\u00a0¤)"},
+	"fr-CH":   {"#,##0.00\u00a0¤;-#,##0.00\u00a0¤", "#,##0.00\u00a0¤;-#,##0.00\u00a0¤"},
+	"hi":      {"¤#,##,##0.00", "¤#,##,##0.00"},
+	"it":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"it-CH":   {"¤\u00a0#,##0.00;¤-#,##0.00", "¤\u00a0#,##0.00;¤-#,##0.00"},
+	"ja":      {"¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	"ko":      {"¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	"nl":      {"¤\u00a0#,##0.00;¤\u00a0-#,##0.00", "¤\u00a0#,##0.00;(¤\u00a0#,##0.00)"},
+	"pl":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"pt":      {"¤\u00a0#,##0.00", "¤\u00a0#,##0.00"},
+	"pt-PT":   {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤;(#,##0.00\u00a0¤)"},
+	"ru":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"sv":      {"#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	"tr":      {"¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	"zh":      {"¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	"zh-Hant": {"¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+}
+
+// A currencyOverride holds a CLDR pattern and separators that replace those
+// of a language for formatting amounts in specific currencies. Empty fields
+// are not overridden.
+type currencyOverride struct {
+	pattern string
+	decimal string
+	group   string
+}
+
+// currencyOverrides holds the overrides per language and currency code. The
+// empty code applies to all currencies and corresponds to the CLDR
+// currencyDecimal and currencyGroup symbols. Overrides of languages that are
+// not listed are inherited from the parent language.
+var currencyOverrides = map[string]map[string]currencyOverride{
+	"de-AT": {"": {group: "."}},
+	"fr-CH": {"": {decimal: "."}},
+	"pt-CV": {"CVE": {pattern: "#,##0.00\u00a0¤", decimal: "$", group: "\u00a0"}},
+	"pt-PT": {"PTE": {pattern: "#,##0.00\u00a0¤", decimal: "$", group: "\u00a0"}},
+}
@@ -5,10 +5,14 @@
 package currency
 
 import (
+	"math"
+	"math/big"
+	"reflect"
 	"testing"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"golang.org/x/text/number"
 )
 
 var (
@@ -29,27 +33,27 @@ func TestFormatting(t *testing.T) {
 		format Formatter
 		want   string
 	}{
-		0: {en, USD.Amount(0.1), nil, "USD 0.10"},
-		1: {en, XPT.Amount(1.0), Symbol, "XPT 1.00"},
+		0: {en, USD.Amount(0.1), nil, "USD\u00a00.10"},
+		1: {en, XPT.Amount(1.0), Symbol, "XPT\u00a01.00"},
 
-		2: {en, USD.Amount(2.0), ISO, "USD 2.00"},
-		3: {und, USD.Amount(3.0), Symbol, "US$ 3.00"},
-		4: {en, USD.Amount(4.0), Symbol, "$ 4.00"},
+		2: {en, USD.Amount(2.0), ISO, "USD\u00a02.00"},
+		3: {und, USD.Amount(3.0), Symbol, "US$\u00a03.00"},
+		4: {en, USD.Amount(4.0), Symbol, "$4.00"},
 
-		5: {en, USD.Amount(5.20), NarrowSymbol, "$ 5.20"},
-		6: {en, AUD.Amount(6.20), Symbol, "A$ 6.20"},
+		5: {en, USD.Amount(5.20), NarrowSymbol, "$5.20"},
+		6: {en, AUD.Amount(6.20), Symbol, "A$6.20"},
 
-		7: {en_AU, AUD.Amount(7.20), Symbol, "$ 7.20"},
-		8: {en_GB, USD.Amount(8.20), Symbol, "US$ 8.20"},
+		7: {en_AU, AUD.Amount(7.20), Symbol, "$7.20"},
+		8: {en_GB, USD.Amount(8.20), Symbol, "US$8.20"},
 
-		9:  {en, 9.0, Symbol.Default(EUR), "€ 9.00"},
-		10: {en, 10.123, Symbol.Default(KRW), "₩ 10"},
-		11: {fr, 11.52, Symbol.Default(TWD), "TWD 11,52"},
-		12: {en, 12.123, Symbol.Default(czk), "CZK 12.12"},
-		13: {en, 13.123, Symbol.Default(czk).Kind(Cash), "CZK 13"},
-		14: {en, 14.12345, ISO.Default(MustParseISO("CLF")), "CLF 14.1235"},
-		15: {en, USD.Amount(15.00), ISO.Default(TWD), "USD 15.00"},
-		16: {en, KRW.Amount(16.00), ISO.Kind(Cash), "KRW 16"},
+		9:  {en, 9.0, Symbol.Default(EUR), "€9.00"},
+		10: {en, 10.123, Symbol.Default(KRW), "₩10"},
+		11: {fr, 11.52, Symbol.Default(TWD), "11,52\u00a0TWD"},
+		12: {en, 12.123, Symbol.Default(czk), "CZK\u00a012.12"},
+		13: {en, 13.123, Symbol.Default(czk).Kind(Cash), "CZK\u00a013"},
+		14: {en, 14.12345, ISO.Default(MustParseISO("CLF")), "CLF\u00a014.1235"},
+		15: {en, USD.Amount(15.00), ISO.Default(TWD), "USD\u00a015.00"},
+		16: {en, KRW.Amount(16.00), ISO.Kind(Cash), "KRW\u00a016"},
 
 		17: {en, USD, nil, "USD"},
 		18: {en, USD, ISO, "USD"},
@@ -58,21 +62,66 @@ func TestFormatting(t *testing.T) {
 		21: {en_AU, USD, NarrowSymbol, "$"},
 
 		// https://en.wikipedia.org/wiki/Decimal_separator
-		22: {de, EUR.Amount(1234567.89), nil, "EUR 1.234.567,89"},
-		23: {fr, EUR.Amount(1234567.89), nil, "EUR 1\u00a0234\u00a0567,89"},
-		24: {en_AU, EUR.Amount(1234567.89), nil, "EUR 1,234,567.89"},
-		25: {de_CH, EUR.Amount(1234567.89), nil, "EUR 1’234’567.89"},
+		22: {de, EUR.Amount(1234567.89), nil, "1.234.567,89\u00a0EUR"},
+		23: {fr, EUR.Amount(1234567.89), nil, "1\u00a0234\u00a0567,89\u00a0EUR"},
+		24: {en_AU, EUR.Amount(1234567.89), nil, "EUR\u00a01,234,567.89"},
+		25: {de_CH, EUR.Amount(1234567.89), nil, "EUR\u00a01’234’567.89"},
 
 		// https://en.wikipedia.org/wiki/Cash_rounding
-		26: {de, NOK.Amount(2.49), ISO.Kind(Cash), "NOK 2"},
-		27: {de, NOK.Amount(2.50), ISO.Kind(Cash), "NOK 3"},
-		28: {de, DKK.Amount(0.24), ISO.Kind(Cash), "DKK 0,00"},
-		29: {de, DKK.Amount(0.25), ISO.Kind(Cash), "DKK 0,50"},
+		26: {de, NOK.Amount(2.49), ISO.Kind(Cash), "2\u00a0NOK"},
+		27: {de, NOK.Amount(2.50), ISO.Kind(Cash), "3\u00a0NOK"},
+		28: {de, DKK.Amount(0.24), ISO.Kind(Cash), "0,00\u00a0DKK"},
+		29: {de, DKK.Amount(0.25), ISO.Kind(Cash), "0,50\u00a0DKK"},
 
 		// integers
-		30: {de, EUR.Amount(1234567), nil, "EUR 1.234.567,00"},
-		31: {en, CNY.Amount(0), NarrowSymbol, "¥ 0.00"},
-		32: {en, CNY.Amount(0), Symbol, "CN¥ 0.00"},
+		30: {de, EUR.Amount(1234567), nil, "1.234.567,00\u00a0EUR"},
+		31: {en, CNY.Amount(0), NarrowSymbol, "¥0.00"},
+		32: {en, CNY.Amount(0), Symbol, "CN¥0.00"},
+
+		// rounding modes
+		33: {en, USD.Amount(0.125), nil, "USD\u00a00.13"},
+		34: {en, USD.Amount(0.125), ISO.RoundingMode(number.ToNearestEven), "USD\u00a00.12"},
+		35: {en, USD.Amount(1.999), ISO.RoundingMode(number.ToZero), "USD\u00a01.99"},
+		36: {en, USD.Amount(-0.001), ISO.RoundingMode(number.ToNegativeInf), "-USD\u00a00.01"},
+		37: {de, NOK.Amount(2.50), ISO.Kind(Cash).RoundingMode(number.ToNearestEven), "2\u00a0NOK"},
+		38: {en, 1.001, Symbol.Default(EUR).RoundingMode(number.AwayFromZero), "€1.01"},
+		39: {en, USD.Amount(0.07), ISO.RoundingMode(number.AwayFromZero), "USD\u00a00.07"},
+		40: {en, USD.Amount(1.005), nil, "USD\u00a01.01"},
+
+		// arbitrary precision
+		41: {en, USD.Amount("12345678901234567.895"), nil, "USD\u00a012,345,678,901,234,567.90"},
+		42: {de, EUR.Amount("-0.125"), ISO.RoundingMode(number.ToNearestEven), "-0,12\u00a0EUR"},
+		43: {en, USD.Amount(big.NewRat(1, 3)), Symbol, "$0.33"},
+		44: {en, USD.Amount(big.NewRat(-2, 3)), nil, "-USD\u00a00.67"},
+		45: {en, JPY.Amount(mkbigint("123456789012345678901234567890")), nil, "JPY\u00a0123,456,789,012,345,678,901,234,567,890"},
+		46: {en, USD.Amount(big.NewFloat(0.125)), nil, "USD\u00a00.13"},
+		47: {de_CH, CHF.Amount(big.NewRat(1, 40)), ISO.Kind(Cash), "CHF\u00a00.05"},
+		48: {de_CH, CHF.Amount("0.0249"), ISO.Kind(Cash), "CHF\u00a00.00"},
+
+		// symbol placement and currency spacing
+		49: {de, EUR.Amount(-3.5), Symbol, "-3,50\u00a0€"},
+		50: {de_CH, CHF.Amount(-3.5), Symbol, "CHF-3.50"},
+		51: {language.MustParse("de-AT"), EUR.Amount(1234.5), Symbol, "€\u00a01.234,50"},
+		52: {language.MustParse("nl"), EUR.Amount(-1234.5), Symbol, "€\u00a0-1.234,50"},
+		53: {language.MustParse("en-IN"), INR.Amount(1234567), Symbol, "₹12,34,567.00"},
+		54: {language.MustParse("ja"), JPY.Amount(1234), Symbol, "￥1,234"},
+		55: {language.MustParse("zh-TW"), TWD.Amount(-12), Symbol, "-$12.00"},
+		56: {language.MustParse("es-MX"), MXN.Amount(5), Symbol, "$5.00"},
+		57: {en, USD.Amount(math.Inf(1)), ISO, "USD∞"},
+
+		// alternative separators and patterns for currencies
+		58: {language.MustParse("fr-CH"), CHF.Amount(1234.5), Symbol, "1\u00a0234.50\u00a0CHF"},
+		59: {language.MustParse("fr-CH"), CHF.Amount(-1234.5), ISO, "-1\u00a0234.50\u00a0CHF"},
+		60: {language.MustParse("pt-CV"), MustParseISO("CVE").Amount(1234.5), Symbol, "1\u00a0234$50\u00a0\u200b"},
+		61: {language.MustParse("pt-PT"), EUR.Amount(1234.5), Symbol, "1\u00a0234,50\u00a0€"},
+
+		// accounting
+		62: {en, USD.Amount(-3.5), Symbol.Kind(Accounting), "($3.50)"},
+		63: {en, USD.Amount(3.5), Symbol.Kind(Accounting), "$3.50"},
+		64: {en, USD.Amount(-3.5), ISO.Kind(Accounting), "(USD\u00a03.50)"},
+		65: {fr, EUR.Amount(-3.5), Symbol.Kind(Accounting), "(3,50\u00a0€)"},
+		66: {de, EUR.Amount(-3.5), Symbol.Kind(Accounting), "-3,50\u00a0€"},
+		67: {language.MustParse("nl"), EUR.Amount(-3.5), Symbol.Kind(Accounting), "(€\u00a03,50)"},
 	}
 	for i, tc := range testCases {
 		p := message.NewPrinter(tc.tag)
@@ -85,3 +134,42 @@ func TestFormatting(t *testing.T) {
 		}
 	}
 }
+
+func mkbigint(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 10)
+	return x
+}
+
+func TestFormatToParts(t *testing.T) {
+	testCases := []struct {
+		tag   language.Tag
+		value interface{}
+		want  []string
+	}{
+		{en, USD.Amount(-1234.5), []string{"MinusSignPart:-", "CurrencyPart:USD", "LiteralPart:\u00a0", "IntegerPart:1", "GroupPart:,", "IntegerPart:234", "DecimalPart:.", "FractionPart:50"}},
+		{de, Symbol(EUR.Amount(3)), []string{"IntegerPart:3", "DecimalPart:,", "FractionPart:00", "LiteralPart:\u00a0", "CurrencyPart:€"}},
+		{en, Symbol.Kind(Accounting)(USD.Amount(-3)), []string{"LiteralPart:(", "CurrencyPart:$", "IntegerPart:3", "DecimalPart:.", "FractionPart:00", "LiteralPart:)"}},
+		{en, Symbol(USD), []string{"CurrencyPart:$"}},
+		{en, Name(USD.Amount(-2)), []string{"MinusSignPart:-", "IntegerPart:2", "DecimalPart:.", "FractionPart:00", "LiteralPart: ", "CurrencyPart:US dollars"}},
+	}
+	for i, tc := range testCases {
+		var s string
+		var parts []number.Part
+		switch v := tc.value.(type) {
+		case Amount:
+			s, parts = v.FormatToParts(tc.tag)
+		case formattedValue:
+			s, parts = v.FormatToParts(tc.tag)
+		}
+		if want := message.NewPrinter(tc.tag).Sprint(tc.value); s != want {
+			t.Errorf("%d: got %q; want %q", i, s, want)
+		}
+		var got []string
+		for _, p := range parts {
+			got = append(got, p.Kind.String()+":"+s[p.Start:p.End])
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%d: got %q; want %q", i, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	inumber "golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A Namer is used to get the name of a currency unit. It has the same method
+// as display.Namer, so that it can be used alongside the namers of package
+// display.
+type Namer interface {
+	// Name returns the display name of the given Unit or *Unit, such as
+	// "US Dollar" for USD in English. It returns the empty string for other
+	// values and for units without a name in the language.
+	Name(x interface{}) string
+}
+
+// Names returns a Namer for naming currency units in language t. It returns nil
+// if there is no data for the given tag.
+func Names(t language.Tag) Namer {
+	key := nameKey(t)
+	for p := key; !p.IsRoot(); p = p.Parent() {
+		if _, ok := currencyNames[p.String()]; ok {
+			return unitNamer{key}
+		}
+	}
+	return nil
+}
+
+type unitNamer struct {
+	tag language.Tag
+}
+
+// Name implements the Namer interface for currency names.
+func (n unitNamer) Name(x interface{}) string {
+	var u Unit
+	switch x := x.(type) {
+	case Unit:
+		u = x
+	case *Unit:
+		u = *x
+	default:
+		return ""
+	}
+	s, _ := lookupName(n.tag, u, plural.Other, false)
+	return s
+}
+
+// nameKey returns the tag used to look up the names and patterns of t.
+func nameKey(t language.Tag) language.Tag {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	return key
+}
+
+// lookupName returns the name of currency c in language t, inheriting names
+// from parent languages. It returns the name for plural form form if count is
+// true and the display name otherwise. It reports whether a name was found.
+func lookupName(t language.Tag, c Unit, form plural.Form, count bool) (string, bool) {
+	if d := c.custom(); d != nil {
+		if count {
+			return d.pluralName(form), true
+		}
+		return d.Name, true
+	}
+	code := c.String()
+	for p := t; ; p = p.Parent() {
+		if s, ok := currencyNames[p.String()][code]; ok {
+			name, forms, _ := strings.Cut(s, "|")
+			if count && forms != "" {
+				return selectForm(forms, form), true
+			}
+			return name, true
+		}
+		if p.IsRoot() {
+			break
+		}
+	}
+	return "", false
+}
+
+// selectForm returns the variant for plural form form from a list of variants
+// of the form "one=US dollar|other=US dollars". It falls back to the variant
+// for plural.Other.
+func selectForm(variants string, form plural.Form) string {
+	other := ""
+	for _, s := range strings.Split(variants, "|") {
+		f, v, _ := strings.Cut(s, "=")
+		switch pluralForms[f] {
+		case form:
+			return v
+		case plural.Other:
+			other = v
+		}
+	}
+	return other
+}
+
+var pluralForms = map[string]plural.Form{
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+	"other": plural.Other,
+}
+
+// lookupUnitPattern returns the pattern for combining an amount, {0}, with
+// the name of its currency, {1}, in language t.
+func lookupUnitPattern(t language.Tag) string {
+	for p := t; !p.IsRoot(); p = p.Parent() {
+		if s, ok := unitPatterns[p.String()]; ok {
+			return s
+		}
+	}
+	return unitPatterns["und"]
+}
+
+// appendName appends amount, formatted with the name of currency cur in
+// language t, to dst. It appends only the display name if amount is nil. If
+// parts is not nil, the parts of the result are appended to it.
+func appendName(dst []byte, parts *[]inumber.Part, t language.Tag, opt *options, cur Unit, amount interface{}) []byte {
+	key := nameKey(t)
+	if amount == nil {
+		name, ok := lookupName(key, cur, plural.Other, false)
+		if !ok {
+			name = cur.String()
+		}
+		return appendPart(dst, parts, inumber.CurrencyPart, name)
+	}
+	var f inumber.Formatter
+	f.InitDecimal(t)
+	opt.setRounding(&f, cur)
+	var d inumber.Decimal
+	d.Convert(f.RoundingContext, amount)
+	digits := inumber.FormatDigits(&d, f.RoundingContext)
+	form := plural.Cardinal.MatchDigits(t, digits.Digits, int(digits.Exp), digits.NumFracDigits())
+	name, ok := lookupName(key, cur, form, true)
+	if !ok {
+		name = cur.String()
+	}
+
+	for s := lookupUnitPattern(key); s != ""; {
+		i := strings.IndexByte(s, '{')
+		if i < 0 || len(s) < i+3 {
+			dst = appendPart(dst, parts, inumber.LiteralPart, s)
+			break
+		}
+		dst = appendPart(dst, parts, inumber.LiteralPart, s[:i])
+		switch s[i : i+3] {
+		case "{0}":
+			f.Parts = parts
+			dst = f.Render(dst, digits)
+		case "{1}":
+			dst = appendPart(dst, parts, inumber.CurrencyPart, name)
+		default:
+			dst = appendPart(dst, parts, inumber.LiteralPart, s[i:i+3])
+		}
+		s = s[i+3:]
+	}
+	return dst
+}
+
+// appendPart appends s to dst and records it as a part of kind k if parts is
+// not nil.
+func appendPart(dst []byte, parts *[]inumber.Part, k inumber.PartKind, s string) []byte {
+	start := len(dst)
+	dst = append(dst, s...)
+	if parts != nil && start < len(dst) {
+		*parts = append(*parts, inumber.Part{Kind: k, Start: start, End: len(dst)})
+	}
+	return dst
+}
@@ -0,0 +1,76 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+// currencyNames holds the CLDR 32 display names of currencies per language,
+// keyed by ISO code. An entry consists of the display name, optionally
+// followed by the names to use with amounts per plural form, as in
+// "US Dollar|one=US dollar|other=US dollars". Names that are not listed are
+// inherited from the parent language.
+var currencyNames = map[string]map[string]string{
+	"de": {
+		"AUD": "Australischer Dollar|one=Australischer Dollar|other=Australische Dollar",
+		"CAD": "Kanadischer Dollar|one=Kanadischer Dollar|other=Kanadische Dollar",
+		"CHF": "Schweizer Franken|one=Schweizer Franken|other=Schweizer Franken",
+		"EUR": "Euro|one=Euro|other=Euro",
+		"GBP": "Britisches Pfund|one=Britisches Pfund|other=Britische Pfund",
+		"JPY": "Japanischer Yen|one=Japanischer Yen|other=Japanische Yen",
+		"USD": "US-Dollar|one=US-Dollar|other=US-Dollar",
+	},
+	"en": {
+		"AUD": "Australian Dollar|one=Australian dollar|other=Australian dollars",
+		"BRL": "Brazilian Real|one=Brazilian real|other=Brazilian reals",
+		"CAD": "Canadian Dollar|one=Canadian dollar|other=Canadian dollars",
+		"CHF": "Swiss Franc|one=Swiss franc|other=Swiss francs",
+		"CNY": "Chinese Yuan|one=Chinese yuan|other=Chinese yuan",
+		"EUR": "Euro|one=euro|other=euros",
+		"GBP": "British Pound|one=British pound|other=British pounds",
+		"INR": "Indian Rupee|one=Indian rupee|other=Indian rupees",
+		"JPY": "Japanese Yen|one=Japanese yen|other=Japanese yen",
+		"MXN": "Mexican Peso|one=Mexican peso|other=Mexican pesos",
+		"RUB": "Russian Ruble|one=Russian ruble|other=Russian rubles",
+		"USD": "US Dollar|one=US dollar|other=US dollars",
+	},
+	"es": {
+		"EUR": "euro|one=euro|other=euros",
+		"GBP": "libra esterlina|one=libra esterlina|other=libras esterlinas",
+		"JPY": "yen|one=yen|other=yenes",
+		"MXN": "peso mexicano|one=peso mexicano|other=pesos mexicanos",
+		"USD": "dólar estadounidense|one=dólar estadounidense|other=dólares estadounidenses",
+	},
+	"fr": {
+		"CAD": "dollar canadien|one=dollar canadien|other=dollars canadiens",
+		"CHF": "franc suisse|one=franc suisse|other=francs suisses",
+		"EUR": "euro|one=euro|other=euros",
+		"GBP": "livre sterling|one=livre sterling|other=livres sterling",
+		"JPY": "yen japonais|one=yen japonais|other=yens japonais",
+		"USD": "dollar des États-Unis|one=dollar des États-Unis|other=dollars des États-Unis",
+	},
+	"nl": {
+		"EUR": "Euro|one=euro|other=euro",
+		"GBP": "Brits pond|one=Brits pond|other=Brits pond",
+		"USD": "Amerikaanse dollar|one=Amerikaanse dollar|other=Amerikaanse dollar",
+	},
+	"ru": {
+		"EUR": "евро|one=евро|few=евро|many=евро|other=евро",
+		"RUB": "российский рубль|one=российский рубль|few=российских рубля|many=российских рублей|other=российского рубля",
+		"USD": "доллар США|one=доллар США|few=доллара США|many=долларов США|other=доллара США",
+	},
+	"zh": {
+		"CNY": "人民币",
+		"EUR": "欧元",
+		"GBP": "英镑",
+		"JPY": "日元",
+		"USD": "美元",
+	},
+}
+
+// unitPatterns holds the CLDR patterns for combining an amount, {0}, with the
+// name of its currency, {1}. Patterns of languages that are not listed are
+// inherited from the parent language.
+var unitPatterns = map[string]string{
+	"und": "{0} {1}",
+	"zh":  "{0}{1}",
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestNames(t *testing.T) {
+	testCases := []struct {
+		lang string
+		x    interface{}
+		want string
+	}{
+		{"en", USD, "US Dollar"},
+		{"en-GB", &EUR, "Euro"},
+		{"en", XPT, ""},
+		{"en", 1, ""},
+		{"fr-CA", CHF, "franc suisse"},
+		{"de-CH", GBP, "Britisches Pfund"},
+		{"ru", RUB, "российский рубль"},
+		{"zh", CNY, "人民币"},
+	}
+	for _, tc := range testCases {
+		n := Names(language.MustParse(tc.lang))
+		if got := n.Name(tc.x); got != tc.want {
+			t.Errorf("%s:%v: got %q; want %q", tc.lang, tc.x, got, tc.want)
+		}
+	}
+	if n := Names(language.MustParse("tlh")); n != nil {
+		t.Errorf("Names(tlh) = %v; want nil", n)
+	}
+}
+
+func TestNameFormatting(t *testing.T) {
+	testCases := []struct {
+		lang  string
+		value interface{}
+		want  string
+	}{
+		{"en", USD, "US Dollar"},
+		{"en", XPT, "XPT"},
+		{"en", USD.Amount(1), "1.00 US dollars"},
+		{"en", USD.Amount(1.5), "1.50 US dollars"},
+		{"en", JPY.Amount(1), "1 Japanese yen"},
+		{"en", JPY.Amount(2), "2 Japanese yen"},
+		{"en", EUR.Amount(-1234.5), "-1,234.50 euros"},
+		{"en", XPT.Amount(3), "3.00 XPT"},
+		{"fr", EUR.Amount(1.5), "1,50 euro"},
+		{"fr", EUR.Amount(2), "2,00 euros"},
+		{"de", GBP.Amount(1000), "1.000,00 Britische Pfund"},
+		{"ru", RUB.Amount(2.5), "2,50 российского рубля"},
+		{"ru", JPY.Amount(1), "1 JPY"},
+		{"zh", USD.Amount(12), "12.00美元"},
+	}
+	for _, tc := range testCases {
+		p := message.NewPrinter(language.MustParse(tc.lang))
+		if got := p.Sprint(Name(tc.value)); got != tc.want {
+			t.Errorf("%s:%v: got %q; want %q", tc.lang, tc.value, got, tc.want)
+		}
+	}
+}
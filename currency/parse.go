@@ -0,0 +1,313 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/internal/language/compact"
+	inumber "golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+var (
+	errNoCurrency = errors.New("currency: amount has no currency")
+	errUnknown    = errors.New("currency: unrecognized currency")
+	errAmbiguous  = errors.New("currency: ambiguous currency symbol")
+)
+
+// A ParseOption configures the parsing of currency amounts.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	strict bool
+	region language.Region
+}
+
+// Strict rejects amounts with a symbol that denotes more than one currency in
+// the language, such as "$", unless the currency of the region hint is one of
+// them. The region hint is the region passed with RegionHint or, if none is
+// given, the region of the language tag if it is specified explicitly.
+var Strict ParseOption = strict
+
+func strict(o *parseOptions) {
+	o.strict = true
+}
+
+// RegionHint resolves ambiguous symbols to the currency of region r, if the
+// symbol denotes it. For example, "$" is parsed as CAD with the hint CA.
+func RegionHint(r language.Region) ParseOption {
+	return func(o *parseOptions) {
+		o.region = r
+	}
+}
+
+// ParseAmount parses a currency amount formatted according to the conventions
+// of language t, such as "1.234,50 €" in German or "($1,234.50)" in English
+// accounting form, and returns it with the currency that was detected.
+//
+// The currency may be given by its ISO code, its symbol or narrow symbol in
+// the language, or its name in the language, such as "3 US dollars", and may
+// precede or follow the number. ISO codes and names are matched regardless of
+// case. Amounts are parsed leniently, as with number.Lenient, using the
+// separators that the language uses for the detected currency. A minus sign or
+// enclosing parentheses denote a negative amount.
+//
+// A symbol that denotes more than one currency, such as "$", is resolved to
+// the currency of the region hint, if any, and otherwise to the currency whose
+// regular symbol it is, such as USD for "$" in English. Use Strict to reject
+// such symbols instead.
+func ParseAmount(t language.Tag, s string, opts ...ParseOption) (Amount, error) {
+	var o parseOptions
+	for _, fn := range opts {
+		fn(&o)
+	}
+	s = strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		neg, s = true, s[1:len(s)-1]
+	}
+
+	// Split s into the number and the text before and after it.
+	start := strings.IndexFunc(s, unicode.IsDigit)
+	if start < 0 {
+		return Amount{}, errNotNumber
+	}
+	end := strings.LastIndexFunc(s, unicode.IsDigit) + 1
+	prefix, num, suffix := s[:start], s[start:end], s[end:]
+	if !neg {
+		// Accounting patterns may put the parentheses within the symbol.
+		p, pok := cutRune(prefix, '(')
+		q, qok := cutRune(suffix, ')')
+		if pok && qok {
+			neg, prefix, suffix = true, p, q
+		}
+	}
+
+	prefix, suffix = removeBidi(prefix), removeBidi(suffix)
+	cur, text, err := detect(t, &o, prefix, suffix)
+	if err != nil {
+		return Amount{}, err
+	}
+	prefix = strings.Replace(prefix, text, "", 1)
+	suffix = strings.Replace(suffix, text, "", 1)
+
+	// The number is parsed with the separators of the language, so replace
+	// those specific to the currency.
+	cf := lookupFormat(t, cur, standardStyle)
+	if cf.decimal != "" || cf.group != "" {
+		info := inumber.InfoFromTag(t)
+		var r []string
+		if cf.decimal != "" {
+			r = append(r, cf.decimal, info.Symbol(inumber.SymDecimal))
+		}
+		if cf.group != "" {
+			r = append(r, cf.group, info.Symbol(inumber.SymGroup))
+		}
+		num = strings.NewReplacer(r...).Replace(num)
+	}
+
+	d, err := number.ParseDecimal(t, prefix+num+suffix, number.Lenient())
+	if err != nil {
+		return Amount{}, err
+	}
+	if d == "NaN" || strings.HasSuffix(d, "Inf") {
+		return Amount{}, errNotNumber
+	}
+	if neg {
+		if strings.HasPrefix(d, "-") {
+			return Amount{}, errNotNumber
+		}
+		d = "-" + d
+	}
+	return Amount{amount: d, currency: cur}, nil
+}
+
+// detect returns the currency denoted by the text in prefix or suffix, which
+// may also contain a sign, and the text that denotes it.
+func detect(t language.Tag, o *parseOptions, prefix, suffix string) (cur Unit, text string, err error) {
+	text = currencyText(prefix)
+	if s := currencyText(suffix); text == "" {
+		text = s
+	} else if s != "" {
+		return Unit{}, "", errUnknown
+	}
+	if text == "" {
+		return Unit{}, "", errNoCurrency
+	}
+	m := lookupMatches(t, strings.ToLower(text))
+	if len(m) == 0 {
+		return Unit{}, "", errUnknown
+	}
+	if len(m) == 1 {
+		return m[0].unit, text, nil
+	}
+
+	region, conf := o.region, language.Exact
+	if region == (language.Region{}) {
+		region, conf = t.Region()
+	}
+	if conf == language.Exact || !o.strict {
+		if c, ok := FromRegion(region); ok {
+			for _, x := range m {
+				if x.unit == c {
+					return c, text, nil
+				}
+			}
+		}
+	}
+	if o.strict {
+		return Unit{}, "", errAmbiguous
+	}
+	// Pick the currency for which the text is the most specific.
+	best := m[0]
+	for _, x := range m[1:] {
+		if x.kind < best.kind {
+			best = x
+		}
+	}
+	return best.unit, text, nil
+}
+
+// currencyText returns s, which is text surrounding a number, without
+// surrounding signs and spaces.
+func currencyText(s string) string {
+	return strings.TrimFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || isSign(r)
+	})
+}
+
+// removeBidi removes bidi control characters, such as the left-to-right mark,
+// from s.
+func removeBidi(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Bidi_Control, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isSign(r rune) bool {
+	return strings.ContainsRune("-+\u2212", r)
+}
+
+// cutRune removes the first occurrence of r from s and reports whether it was
+// found.
+func cutRune(s string, r rune) (string, bool) {
+	i := strings.IndexRune(s, r)
+	if i < 0 {
+		return s, false
+	}
+	return s[:i] + s[i+len(string(r)):], true
+}
+
+// A matchKind indicates how a text denotes a currency. Lower values are more
+// specific.
+type matchKind uint8
+
+const (
+	codeMatch matchKind = iota
+	nameMatch
+	symbolMatch
+	narrowMatch
+)
+
+type match struct {
+	unit Unit
+	kind matchKind
+}
+
+var matchCache sync.Map // language.Tag -> map[string][]match
+
+// lookupMatches returns the currencies denoted by the lower-case text s in
+// language t, with at most one match per currency.
+func lookupMatches(t language.Tag, s string) []match {
+	key := nameKey(t)
+	v, ok := matchCache.Load(key)
+	if !ok {
+		v, _ = matchCache.LoadOrStore(key, buildMatches(t, key))
+	}
+	m := append([]match(nil), v.(map[string][]match)[s]...)
+
+	registry.RLock()
+	defer registry.RUnlock()
+	for i, d := range registry.units {
+		u := Unit{uint16(customBase + i)}
+		for _, x := range []struct {
+			s    string
+			kind matchKind
+		}{
+			{d.Code, codeMatch},
+			{d.Name, nameMatch},
+			{d.Symbol, symbolMatch},
+			{d.NarrowSymbol, narrowMatch},
+		} {
+			if strings.ToLower(x.s) == s {
+				m = addMatch(m, u, x.kind)
+			}
+		}
+		for _, n := range d.PluralNames {
+			if strings.ToLower(n) == s {
+				m = addMatch(m, u, nameMatch)
+			}
+		}
+	}
+	return m
+}
+
+// buildMatches returns the texts that denote ISO currencies in language t,
+// where key is the tag used for looking up names.
+func buildMatches(t language.Tag, key language.Tag) map[string][]match {
+	lang, _ := compact.RegionalID(compact.Tag(t))
+	m := map[string][]match{}
+	add := func(s string, u Unit, k matchKind) {
+		s = strings.ToLower(s)
+		m[s] = addMatch(m[s], u, k)
+	}
+	for i := 1; i <= numCurrencies; i++ {
+		if i == xxx {
+			continue
+		}
+		u := Unit{uint16(i)}
+		add(u.String(), u, codeMatch)
+		add(normalSymbol.lookup(lang, u), u, symbolMatch)
+		add(narrowSymbol.lookup(lang, u), u, narrowMatch)
+	}
+	for p := key; ; p = p.Parent() {
+		for code, s := range currencyNames[p.String()] {
+			u := MustParseISO(code)
+			name, forms, _ := strings.Cut(s, "|")
+			add(name, u, nameMatch)
+			for _, f := range strings.Split(forms, "|") {
+				if _, v, ok := strings.Cut(f, "="); ok {
+					add(v, u, nameMatch)
+				}
+			}
+		}
+		if p.IsRoot() {
+			break
+		}
+	}
+	return m
+}
+
+// addMatch adds a match of u of kind k to m, keeping only the most specific
+// match for each currency.
+func addMatch(m []match, u Unit, k matchKind) []match {
+	for i, x := range m {
+		if x.unit == u {
+			if k < x.kind {
+				m[i].kind = k
+			}
+			return m
+		}
+	}
+	return append(m, match{u, k})
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseAmount(t *testing.T) {
+	ca := RegionHint(language.MustParseRegion("CA"))
+	testCases := []struct {
+		tag  string
+		in   string
+		opts []ParseOption
+		cur  Unit
+		want string
+	}{
+		{"de", "1.234,50 €", nil, EUR, "1234.50"},
+		{"de", "1.234,50\u00a0€", nil, EUR, "1234.50"},
+		{"de", "-1.234,50\u00a0€", nil, EUR, "-1234.50"},
+		{"de", "€ 1.234,50", nil, EUR, "1234.50"},
+		{"de", "3 Euro", nil, EUR, "3"},
+		{"de", "1.234,50 eur", nil, EUR, "1234.50"},
+		{"en", "$1,234.50", nil, USD, "1234.50"},
+		{"en", " -$1,234.50 ", nil, USD, "-1234.50"},
+		{"en", "($1,234.50)", nil, USD, "-1234.50"},
+		{"en", "$(1,234.50)", nil, USD, "-1234.50"},
+		{"en", "£-12", nil, GBP, "-12"},
+		{"en", "USD\u00a03", nil, USD, "3"},
+		{"en", "CA$12", nil, CAD, "12"},
+		{"en", "3 US dollars", nil, USD, "3"},
+		{"en", "1 US Dollar", nil, USD, "1"},
+		{"en-CA", "$5", nil, CAD, "5"},
+		{"en", "$5", []ParseOption{ca}, CAD, "5"},
+		{"en-US", "$5", []ParseOption{Strict}, USD, "5"},
+		{"en", "$5", []ParseOption{Strict, ca}, CAD, "5"},
+		{"en", "€5", []ParseOption{Strict}, EUR, "5"},
+		{"fr-CH", "1\u00a0234.50\u00a0CHF", nil, CHF, "1234.50"},
+		{"de-CH", "CHF 1’234.50", nil, CHF, "1234.50"},
+		{"de-AT", "€ 1.234,50", nil, EUR, "1234.50"},
+		{"ja", "￥1,234", nil, JPY, "1234"},
+		{"ru", "5 российских рублей", nil, RUB, "5"},
+		{"en", "12 points", nil, testPoints, "12"},
+		{"en", "pts 12", nil, testPoints, "12"},
+	}
+	for _, tc := range testCases {
+		a, err := ParseAmount(language.MustParse(tc.tag), tc.in, tc.opts...)
+		if err != nil {
+			t.Errorf("%s:%q: unexpected error: %v", tc.tag, tc.in, err)
+			continue
+		}
+		if a.Currency() != tc.cur || a.Decimal() != tc.want {
+			t.Errorf("%s:%q: got %v %s; want %v %s", tc.tag, tc.in, a.Currency(), a.Decimal(), tc.cur, tc.want)
+		}
+	}
+}
+
+func TestParseAmountErrors(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		in   string
+		opts []ParseOption
+		err  error
+	}{
+		{"en", "", nil, errNotNumber},
+		{"en", "USD", nil, errNotNumber},
+		{"en", "1234", nil, errNoCurrency},
+		{"en", "1234 foo", nil, errUnknown},
+		{"en", "USD 12 EUR", nil, errUnknown},
+		{"en", "(-$12)", nil, errNotNumber},
+		{"en", "$5", []ParseOption{Strict}, errAmbiguous},
+		{"en-AU", "$5", []ParseOption{Strict}, nil},
+		{"en-GB", "$5", []ParseOption{Strict}, errAmbiguous},
+	}
+	for _, tc := range testCases {
+		_, err := ParseAmount(language.MustParse(tc.tag), tc.in, tc.opts...)
+		if err != tc.err {
+			t.Errorf("%s:%q: got error %v; want %v", tc.tag, tc.in, err, tc.err)
+		}
+	}
+	if _, err := ParseAmount(language.German, "1,2,3 €"); err == nil {
+		t.Errorf("de:%q: unexpected success", "1,2,3 €")
+	}
+}
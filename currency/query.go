@@ -40,8 +40,10 @@ type QueryIter interface {
 // Query represents a set of Units. The default set includes all Units that are
 // currently in use as legal tender in any Region.
 func Query(options ...QueryOption) QueryIter {
+	data := regions()
 	it := &iter{
-		end:  len(regionData),
+		data: data,
+		end:  len(data),
 		date: 0xFFFFFFFF,
 	}
 	for _, fn := range options {
@@ -79,19 +81,20 @@ func Date(t time.Time) QueryOption {
 
 // Region limits the query to only return entries for the given region.
 func Region(r language.Region) QueryOption {
-	p, end := len(regionData), len(regionData)
 	x := regionToCode(r)
-	i := sort.Search(len(regionData), func(i int) bool {
-		return regionData[i].region >= x
-	})
-	if i < len(regionData) && regionData[i].region == x {
-		p = i
-		for i++; i < len(regionData) && regionData[i].region == x; i++ {
+	return func(it *iter) {
+		data := it.data
+		p, end := len(data), len(data)
+		i := sort.Search(len(data), func(i int) bool {
+			return data[i].region >= x
+		})
+		if i < len(data) && data[i].region == x {
+			p = i
+			for i++; i < len(data) && data[i].region == x; i++ {
+			}
+			end = i
 		}
-		end = i
-	}
-	return func(i *iter) {
-		i.p, i.end = p, end
+		it.p, it.end = p, end
 	}
 }
 
@@ -102,6 +105,7 @@ const (
 
 type iter struct {
 	*regionInfo
+	data      []regionInfo
 	p, end    int
 	date      uint32
 	nonTender bool
@@ -109,7 +113,7 @@ type iter struct {
 
 func (i *iter) Next() bool {
 	for ; i.p < i.end; i.p++ {
-		i.regionInfo = &regionData[i.p]
+		i.regionInfo = &i.data[i.p]
 		if !i.nonTender && !i.IsTender() {
 			continue
 		}
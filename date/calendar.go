@@ -0,0 +1,107 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "time"
+
+// A calendar identifies a calendar system as defined by the BCP 47 -u-ca key.
+type calendar int
+
+const (
+	gregorian calendar = iota
+	buddhist
+	japanese
+	roc
+)
+
+var calendarIDs = []string{
+	gregorian: "gregory",
+	buddhist:  "buddhist",
+	japanese:  "japanese",
+	roc:       "roc",
+}
+
+func (c calendar) String() string { return calendarIDs[c] }
+
+// parseCalendar returns the calendar for the given BCP 47 calendar type. All
+// calendar types that are not supported by this package, including iso8601,
+// map to the Gregorian calendar.
+func parseCalendar(s string) calendar {
+	for i, id := range calendarIDs {
+		if id == s {
+			return calendar(i)
+		}
+	}
+	return gregorian
+}
+
+// japaneseEras lists the start dates of the eras of the Japanese calendar
+// that are supported by this package, in order.
+var japaneseEras = []time.Time{
+	time.Date(1868, 9, 8, 0, 0, 0, 0, time.UTC),   // Meiji
+	time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC),  // Taishō
+	time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), // Shōwa
+	time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC),   // Heisei
+	time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC),   // Reiwa
+}
+
+// eraYear returns the era index and the year within the era for t, as well
+// as the calendar for which the era index applies. Dates before the first era
+// of a calendar are returned as Gregorian dates.
+func (c calendar) eraYear(t time.Time) (cal calendar, era, year int) {
+	y := t.Year()
+	switch c {
+	case buddhist:
+		return buddhist, 0, y + 543
+	case roc:
+		if y > 1911 {
+			return roc, 1, y - 1911
+		}
+		return roc, 0, 1912 - y
+	case japanese:
+		d := time.Date(y, t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		for i := len(japaneseEras) - 1; i >= 0; i-- {
+			if start := japaneseEras[i]; !d.Before(start) {
+				return japanese, i, y - start.Year() + 1
+			}
+		}
+	}
+	if y <= 0 {
+		return gregorian, 0, 1 - y
+	}
+	return gregorian, 1, y
+}
+
+// weekOfYear returns the week-based year and week number of t for a week that
+// starts on firstDay and where the first week of the year has at least
+// minDays days.
+func weekOfYear(t time.Time, firstDay, minDays int) (year, week int) {
+	year = t.Year()
+	yday := t.YearDay() - 1
+	// offset is the number of days in the year before the start of the first
+	// week, which may be negative.
+	offset := func(yday int, wday time.Weekday) int {
+		jan1 := (int(wday) - yday%7 + 7) % 7 // weekday of January 1st
+		n := (jan1 - firstDay + 7) % 7       // days of January in previous week
+		if 7-n >= minDays {
+			return -n
+		}
+		return 7 - n
+	}
+	start := offset(yday, t.Weekday())
+	if yday < start {
+		// Part of the last week of the previous year.
+		prev := time.Date(year-1, 12, 31, 0, 0, 0, 0, time.UTC)
+		return weekOfYear(prev, firstDay, minDays)
+	}
+	week = (yday-start)/7 + 1
+	// Check whether the date falls in the first week of the next year.
+	daysInYear := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	next := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	if nextStart := offset(0, next.Weekday()); yday >= daysInYear+nextStart {
+		return year + 1, 1
+	}
+	return year, week
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEraYear(t *testing.T) {
+	testCases := []struct {
+		cal       calendar
+		date      time.Time
+		wantCal   calendar
+		era, year int
+	}{
+		{gregorian, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), gregorian, 1, 2006},
+		{gregorian, time.Date(0, 1, 2, 0, 0, 0, 0, time.UTC), gregorian, 0, 1},
+		{buddhist, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), buddhist, 0, 2549},
+		{roc, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), roc, 1, 95},
+		{roc, time.Date(1900, 1, 2, 0, 0, 0, 0, time.UTC), roc, 0, 12},
+		{japanese, time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC), japanese, 2, 64},
+		{japanese, time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), japanese, 3, 1},
+		{japanese, time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), japanese, 3, 31},
+		{japanese, time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), japanese, 4, 1},
+		{japanese, time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC), gregorian, 1, 1800},
+	}
+	for _, tc := range testCases {
+		cal, era, year := tc.cal.eraYear(tc.date)
+		if cal != tc.wantCal || era != tc.era || year != tc.year {
+			t.Errorf("%v.eraYear(%v) = %v, %d, %d; want %v, %d, %d",
+				tc.cal, tc.date, cal, era, year, tc.wantCal, tc.era, tc.year)
+		}
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	testCases := []struct {
+		date              string
+		firstDay, minDays int
+		year, week        int
+	}{
+		// ISO 8601 weeks.
+		{"2005-01-01", 1, 4, 2004, 53},
+		{"2005-01-03", 1, 4, 2005, 1},
+		{"2008-12-29", 1, 4, 2009, 1},
+		{"2010-01-03", 1, 4, 2009, 53},
+		{"2010-01-04", 1, 4, 2010, 1},
+		{"2006-01-02", 1, 4, 2006, 1},
+
+		// US weeks.
+		{"2005-01-01", 0, 1, 2005, 1},
+		{"2005-01-02", 0, 1, 2005, 2},
+		{"2008-12-28", 0, 1, 2009, 1},
+		{"2006-12-31", 0, 1, 2007, 1},
+	}
+	for _, tc := range testCases {
+		d, _ := time.Parse("2006-01-02", tc.date)
+		year, week := weekOfYear(d, tc.firstDay, tc.minDays)
+		if year != tc.year || week != tc.week {
+			t.Errorf("weekOfYear(%s, %d, %d) = %d, %d; want %d, %d",
+				tc.date, tc.firstDay, tc.minDays, year, week, tc.year, tc.week)
+		}
+	}
+}
@@ -0,0 +1,879 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+// This file contains the CLDR calendar data for the locales supported by this
+// package. The data is derived from the CLDR 32 "dates" section, the same
+// source used by gen.go. Only the Gregorian calendar is included in full;
+// other calendars borrow the Gregorian month and day names and only define
+// their own eras.
+//
+// Lists of names are separated by '|'. Empty values are inherited from the
+// parent locale, following the CLDR inheritance rules.
+
+// Indices for the CLDR name contexts.
+const (
+	contextFormat = iota
+	contextStandAlone
+
+	numContexts
+)
+
+// Indices for the CLDR name widths. Note that the short width is only defined
+// for days.
+const (
+	widthAbbreviated = iota
+	widthWide
+	widthNarrow
+	widthShort
+
+	numWidths
+)
+
+// localeData holds the raw calendar data for a single locale.
+type localeData struct {
+	months     [numContexts][numWidths]string
+	days       [numContexts][numWidths]string
+	quarters   [numContexts][numWidths]string
+	dayPeriods [numWidths]string // AM|PM
+	eras       map[string][numWidths]string
+
+	// Standard patterns for the lengths Full, Long, Medium, and Short.
+	dateFormats     [numLengths]string
+	timeFormats     [numLengths]string
+	dateTimeFormats [numLengths]string
+
+	// genericDateFormats are used for calendars other than the Gregorian
+	// calendar.
+	genericDateFormats [numLengths]string
+
+	// availableFormats maps skeletons to patterns.
+	availableFormats map[string]string
+
+	gmtFormat     string
+	gmtZeroFormat string
+	hourFormat    string
+}
+
+var locales = map[string]*localeData{
+	"und": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "M01|M02|M03|M04|M05|M06|M07|M08|M09|M10|M11|M12",
+				widthWide:        "M01|M02|M03|M04|M05|M06|M07|M08|M09|M10|M11|M12",
+				widthNarrow:      "1|2|3|4|5|6|7|8|9|10|11|12",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Sun|Mon|Tue|Wed|Thu|Fri|Sat",
+				widthWide:        "Sun|Mon|Tue|Wed|Thu|Fri|Sat",
+				widthNarrow:      "S|M|T|W|T|F|S",
+				widthShort:       "Sun|Mon|Tue|Wed|Thu|Fri|Sat",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Q1|Q2|Q3|Q4",
+				widthWide:        "Q1|Q2|Q3|Q4",
+				widthNarrow:      "1|2|3|4",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "AM|PM",
+			widthWide:        "AM|PM",
+			widthNarrow:      "AM|PM",
+		},
+		eras: map[string][numWidths]string{
+			"gregory":  {widthAbbreviated: "BCE|CE", widthWide: "BCE|CE", widthNarrow: "BCE|CE"},
+			"buddhist": {widthAbbreviated: "BE", widthWide: "BE", widthNarrow: "BE"},
+			"roc":      {widthAbbreviated: "Before R.O.C.|Minguo", widthWide: "Before R.O.C.|Minguo", widthNarrow: "Before R.O.C.|Minguo"},
+			"japanese": {
+				widthAbbreviated: "Meiji|Taishō|Shōwa|Heisei|Reiwa",
+				widthWide:        "Meiji|Taishō|Shōwa|Heisei|Reiwa",
+				widthNarrow:      "M|T|S|H|R",
+			},
+		},
+		dateFormats:        [numLengths]string{"y MMMM d, EEEE", "y MMMM d", "y MMM d", "y-MM-dd"},
+		timeFormats:        [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats:    [numLengths]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		genericDateFormats: [numLengths]string{"G y MMMM d, EEEE", "G y MMMM d", "G y MMM d", "GGGGG y-MM-dd"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "ccc",
+			"Ed":      "d, E",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E HH:mm",
+			"Ehms":    "E h:mm:ss a",
+			"EHms":    "E HH:mm:ss",
+			"Gy":      "G y",
+			"GyMMM":   "G y MMM",
+			"GyMMMd":  "G y MMM d",
+			"GyMMMEd": "G y MMM d, E",
+			"h":       "h a",
+			"H":       "HH",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "MM-dd",
+			"MEd":     "MM-dd, E",
+			"MMM":     "LLL",
+			"MMMd":    "MMM d",
+			"MMMEd":   "MMM d, E",
+			"MMMMd":   "MMMM d",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "y-MM",
+			"yMd":     "y-MM-dd",
+			"yMEd":    "y-MM-dd, E",
+			"yMMM":    "y MMM",
+			"yMMMd":   "y MMM d",
+			"yMMMEd":  "y MMM d, E",
+			"yMMMM":   "y MMMM",
+			"yQQQ":    "y QQQ",
+			"yQQQQ":   "y QQQQ",
+		},
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+	},
+	"en": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec",
+				widthWide:        "January|February|March|April|May|June|July|August|September|October|November|December",
+				widthNarrow:      "J|F|M|A|M|J|J|A|S|O|N|D",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Sun|Mon|Tue|Wed|Thu|Fri|Sat",
+				widthWide:        "Sunday|Monday|Tuesday|Wednesday|Thursday|Friday|Saturday",
+				widthNarrow:      "S|M|T|W|T|F|S",
+				widthShort:       "Su|Mo|Tu|We|Th|Fr|Sa",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Q1|Q2|Q3|Q4",
+				widthWide:        "1st quarter|2nd quarter|3rd quarter|4th quarter",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "AM|PM",
+			widthWide:        "AM|PM",
+			widthNarrow:      "a|p",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "BC|AD", widthWide: "Before Christ|Anno Domini", widthNarrow: "B|A"},
+			"roc":     {widthAbbreviated: "B.R.O.C.|Minguo", widthWide: "Before R.O.C.|Minguo", widthNarrow: "B.R.O.C.|Minguo"},
+		},
+		dateFormats:        [numLengths]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+		timeFormats:        [numLengths]string{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"},
+		dateTimeFormats:    [numLengths]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+		genericDateFormats: [numLengths]string{"EEEE, MMMM d, y G", "MMMM d, y G", "MMM d, y G", "M/d/y GGGGG"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "ccc",
+			"Ed":      "d E",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E HH:mm",
+			"Ehms":    "E h:mm:ss a",
+			"EHms":    "E HH:mm:ss",
+			"Gy":      "y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMd":  "MMM d, y G",
+			"GyMMMEd": "E, MMM d, y G",
+			"h":       "h a",
+			"H":       "HH",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "M/d",
+			"MEd":     "E, M/d",
+			"MMM":     "LLL",
+			"MMMd":    "MMM d",
+			"MMMEd":   "E, MMM d",
+			"MMMMd":   "MMMM d",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M/y",
+			"yMd":     "M/d/y",
+			"yMEd":    "E, M/d/y",
+			"yMMM":    "MMM y",
+			"yMMMd":   "MMM d, y",
+			"yMMMEd":  "E, MMM d, y",
+			"yMMMM":   "MMMM y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+	},
+	"en-GB": {
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "am|pm",
+			widthWide:        "am|pm",
+		},
+		dateFormats:        [numLengths]string{"EEEE, d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		timeFormats:        [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		genericDateFormats: [numLengths]string{"EEEE, d MMMM y G", "d MMMM y G", "d MMM y G", "dd/MM/y GGGGG"},
+		availableFormats: map[string]string{
+			"Ed":      "E d",
+			"GyMMMd":  "d MMM y G",
+			"GyMMMEd": "E, d MMM y G",
+			"Md":      "dd/MM",
+			"MEd":     "E dd/MM",
+			"MMMd":    "d MMM",
+			"MMMEd":   "E d MMM",
+			"MMMMd":   "d MMMM",
+			"yM":      "MM/y",
+			"yMd":     "dd/MM/y",
+			"yMEd":    "E, dd/MM/y",
+			"yMMMd":   "d MMM y",
+			"yMMMEd":  "E, d MMM y",
+		},
+	},
+	"de": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Jan.|Feb.|März|Apr.|Mai|Juni|Juli|Aug.|Sep.|Okt.|Nov.|Dez.",
+				widthWide:        "Januar|Februar|März|April|Mai|Juni|Juli|August|September|Oktober|November|Dezember",
+				widthNarrow:      "J|F|M|A|M|J|J|A|S|O|N|D",
+			},
+			contextStandAlone: {
+				widthAbbreviated: "Jan|Feb|Mär|Apr|Mai|Jun|Jul|Aug|Sep|Okt|Nov|Dez",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "So.|Mo.|Di.|Mi.|Do.|Fr.|Sa.",
+				widthWide:        "Sonntag|Montag|Dienstag|Mittwoch|Donnerstag|Freitag|Samstag",
+				widthNarrow:      "S|M|D|M|D|F|S",
+				widthShort:       "So.|Mo.|Di.|Mi.|Do.|Fr.|Sa.",
+			},
+			contextStandAlone: {
+				widthAbbreviated: "So|Mo|Di|Mi|Do|Fr|Sa",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Q1|Q2|Q3|Q4",
+				widthWide:        "1. Quartal|2. Quartal|3. Quartal|4. Quartal",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "vorm.|nachm.",
+			widthWide:        "vorm.|nachm.",
+			widthNarrow:      "vm.|nm.",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "v. Chr.|n. Chr.", widthWide: "v. Chr.|n. Chr.", widthNarrow: "v. Chr.|n. Chr."},
+		},
+		dateFormats:        [numLengths]string{"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy"},
+		timeFormats:        [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats:    [numLengths]string{"{1} 'um' {0}", "{1} 'um' {0}", "{1}, {0}", "{1}, {0}"},
+		genericDateFormats: [numLengths]string{"EEEE, d. MMMM y G", "d. MMMM y G", "dd.MM.y G", "dd.MM.yy GGGGG"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "ccc",
+			"Ed":      "E, d.",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E, HH:mm",
+			"Ehms":    "E, h:mm:ss a",
+			"EHms":    "E, HH:mm:ss",
+			"Gy":      "y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMd":  "d. MMM y G",
+			"GyMMMEd": "E, d. MMM y G",
+			"h":       "h 'Uhr' a",
+			"H":       "HH 'Uhr'",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "d.M.",
+			"MEd":     "E, d.M.",
+			"MMd":     "d.MM.",
+			"MMdd":    "dd.MM.",
+			"MMM":     "LLL",
+			"MMMd":    "d. MMM",
+			"MMMEd":   "E, d. MMM",
+			"MMMMd":   "d. MMMM",
+			"MMMMEd":  "E, d. MMMM",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M/y",
+			"yMd":     "d.M.y",
+			"yMEd":    "E, d.M.y",
+			"yMM":     "MM.y",
+			"yMMdd":   "dd.MM.y",
+			"yMMM":    "MMM y",
+			"yMMMd":   "d. MMM y",
+			"yMMMEd":  "E, d. MMM y",
+			"yMMMM":   "MMMM y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+	},
+	"fr": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "janv.|févr.|mars|avr.|mai|juin|juil.|août|sept.|oct.|nov.|déc.",
+				widthWide:        "janvier|février|mars|avril|mai|juin|juillet|août|septembre|octobre|novembre|décembre",
+				widthNarrow:      "J|F|M|A|M|J|J|A|S|O|N|D",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "dim.|lun.|mar.|mer.|jeu.|ven.|sam.",
+				widthWide:        "dimanche|lundi|mardi|mercredi|jeudi|vendredi|samedi",
+				widthNarrow:      "D|L|M|M|J|V|S",
+				widthShort:       "di|lu|ma|me|je|ve|sa",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "T1|T2|T3|T4",
+				widthWide:        "1er trimestre|2e trimestre|3e trimestre|4e trimestre",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "AM|PM",
+			widthWide:        "AM|PM",
+			widthNarrow:      "AM|PM",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "av. J.-C.|ap. J.-C.", widthWide: "avant Jésus-Christ|après Jésus-Christ", widthNarrow: "av. J.-C.|ap. J.-C."},
+		},
+		dateFormats:        [numLengths]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		timeFormats:        [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats:    [numLengths]string{"{1} 'à' {0}", "{1} 'à' {0}", "{1} 'à' {0}", "{1} {0}"},
+		genericDateFormats: [numLengths]string{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd/MM/y GGGGG"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "E",
+			"Ed":      "E d",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E HH:mm",
+			"Ehms":    "E h:mm:ss a",
+			"EHms":    "E HH:mm:ss",
+			"Gy":      "y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMMMEd": "E d MMM y G",
+			"h":       "h a",
+			"H":       "HH 'h'",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "dd/MM",
+			"MEd":     "E dd/MM",
+			"MMM":     "LLL",
+			"MMMd":    "d MMM",
+			"MMMEd":   "E d MMM",
+			"MMMMd":   "d MMMM",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "MM/y",
+			"yMd":     "dd/MM/y",
+			"yMEd":    "E dd/MM/y",
+			"yMMM":    "MMM y",
+			"yMMMd":   "d MMM y",
+			"yMMMEd":  "E d MMM y",
+			"yMMMM":   "MMMM y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		gmtFormat:     "UTC{0}",
+		gmtZeroFormat: "UTC",
+		hourFormat:    "+HH:mm;−HH:mm",
+	},
+	"es": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "ene.|feb.|mar.|abr.|may.|jun.|jul.|ago.|sept.|oct.|nov.|dic.",
+				widthWide:        "enero|febrero|marzo|abril|mayo|junio|julio|agosto|septiembre|octubre|noviembre|diciembre",
+				widthNarrow:      "E|F|M|A|M|J|J|A|S|O|N|D",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "dom.|lun.|mar.|mié.|jue.|vie.|sáb.",
+				widthWide:        "domingo|lunes|martes|miércoles|jueves|viernes|sábado",
+				widthNarrow:      "D|L|M|X|J|V|S",
+				widthShort:       "DO|LU|MA|MI|JU|VI|SA",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "T1|T2|T3|T4",
+				widthWide:        "1.er trimestre|2.º trimestre|3.er trimestre|4.º trimestre",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "a. m.|p. m.",
+			widthWide:        "a. m.|p. m.",
+			widthNarrow:      "a. m.|p. m.",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "a. C.|d. C.", widthWide: "antes de Cristo|después de Cristo", widthNarrow: "a. C.|d. C."},
+		},
+		dateFormats:     [numLengths]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"},
+		timeFormats:     [numLengths]string{"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		dateTimeFormats: [numLengths]string{"{1}, {0}", "{1}, {0}", "{1} {0}", "{1} {0}"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "ccc",
+			"Ed":      "E d",
+			"Ehm":     "E, h:mm a",
+			"EHm":     "E, H:mm",
+			"Ehms":    "E, h:mm:ss a",
+			"EHms":    "E, H:mm:ss",
+			"Gy":      "y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMMMEd": "E, d MMM y G",
+			"h":       "h a",
+			"H":       "H",
+			"hm":      "h:mm a",
+			"Hm":      "H:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "H:mm:ss",
+			"M":       "L",
+			"Md":      "d/M",
+			"MEd":     "E, d/M",
+			"MMM":     "LLL",
+			"MMMd":    "d MMM",
+			"MMMEd":   "E, d MMM",
+			"MMMMd":   "d 'de' MMMM",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M/y",
+			"yMd":     "d/M/y",
+			"yMEd":    "EEE, d/M/y",
+			"yMMM":    "MMM y",
+			"yMMMd":   "d MMM y",
+			"yMMMEd":  "EEE, d MMM y",
+			"yMMMM":   "MMMM 'de' y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ 'de' y",
+		},
+	},
+	"it": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "gen|feb|mar|apr|mag|giu|lug|ago|set|ott|nov|dic",
+				widthWide:        "gennaio|febbraio|marzo|aprile|maggio|giugno|luglio|agosto|settembre|ottobre|novembre|dicembre",
+				widthNarrow:      "G|F|M|A|M|G|L|A|S|O|N|D",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "dom|lun|mar|mer|gio|ven|sab",
+				widthWide:        "domenica|lunedì|martedì|mercoledì|giovedì|venerdì|sabato",
+				widthNarrow:      "D|L|M|M|G|V|S",
+				widthShort:       "dom|lun|mar|mer|gio|ven|sab",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "T1|T2|T3|T4",
+				widthWide:        "1º trimestre|2º trimestre|3º trimestre|4º trimestre",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "AM|PM",
+			widthWide:        "AM|PM",
+			widthNarrow:      "m.|p.",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "a.C.|d.C.", widthWide: "avanti Cristo|dopo Cristo", widthNarrow: "aC|dC"},
+		},
+		dateFormats:     [numLengths]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/yy"},
+		timeFormats:     [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [numLengths]string{"{1} {0}", "{1} {0}", "{1}, {0}", "{1}, {0}"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "EEE",
+			"Ed":      "E d",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E HH:mm",
+			"Ehms":    "E h:mm:ss a",
+			"EHms":    "E HH:mm:ss",
+			"Gy":      "y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMMMEd": "E d MMM y G",
+			"h":       "h a",
+			"H":       "HH",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "d/M",
+			"MEd":     "E d/M",
+			"MMM":     "LLL",
+			"MMMd":    "d MMM",
+			"MMMEd":   "E d MMM",
+			"MMMMd":   "d MMMM",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M/y",
+			"yMd":     "d/M/y",
+			"yMEd":    "E d/M/y",
+			"yMMM":    "MMM y",
+			"yMMMd":   "d MMM y",
+			"yMMMEd":  "E d MMM y",
+			"yMMMM":   "MMMM y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+	},
+	"nl": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "jan.|feb.|mrt.|apr.|mei|jun.|jul.|aug.|sep.|okt.|nov.|dec.",
+				widthWide:        "januari|februari|maart|april|mei|juni|juli|augustus|september|oktober|november|december",
+				widthNarrow:      "J|F|M|A|M|J|J|A|S|O|N|D",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "zo|ma|di|wo|do|vr|za",
+				widthWide:        "zondag|maandag|dinsdag|woensdag|donderdag|vrijdag|zaterdag",
+				widthNarrow:      "Z|M|D|W|D|V|Z",
+				widthShort:       "zo|ma|di|wo|do|vr|za",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "K1|K2|K3|K4",
+				widthWide:        "1e kwartaal|2e kwartaal|3e kwartaal|4e kwartaal",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "a.m.|p.m.",
+			widthWide:        "a.m.|p.m.",
+			widthNarrow:      "a.m.|p.m.",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "v.Chr.|n.Chr.", widthWide: "voor Christus|na Christus", widthNarrow: "v.C.|n.C."},
+		},
+		dateFormats:     [numLengths]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"},
+		timeFormats:     [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [numLengths]string{"{1} 'om' {0}", "{1} 'om' {0}", "{1} {0}", "{1} {0}"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "ccc",
+			"Ed":      "E d",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E HH:mm",
+			"Ehms":    "E h:mm:ss a",
+			"EHms":    "E HH:mm:ss",
+			"Gy":      "y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMMMEd": "E d MMM y G",
+			"h":       "h a",
+			"H":       "HH",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "d-M",
+			"MEd":     "E d-M",
+			"MMM":     "LLL",
+			"MMMd":    "d MMM",
+			"MMMEd":   "E d MMM",
+			"MMMMd":   "d MMMM",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M-y",
+			"yMd":     "d-M-y",
+			"yMEd":    "E d-M-y",
+			"yMMM":    "MMM y",
+			"yMMMd":   "d MMM y",
+			"yMMMEd":  "E d MMM y",
+			"yMMMM":   "MMMM y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+	},
+	"ja": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "1月|2月|3月|4月|5月|6月|7月|8月|9月|10月|11月|12月",
+				widthWide:        "1月|2月|3月|4月|5月|6月|7月|8月|9月|10月|11月|12月",
+				widthNarrow:      "1|2|3|4|5|6|7|8|9|10|11|12",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "日|月|火|水|木|金|土",
+				widthWide:        "日曜日|月曜日|火曜日|水曜日|木曜日|金曜日|土曜日",
+				widthNarrow:      "日|月|火|水|木|金|土",
+				widthShort:       "日|月|火|水|木|金|土",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "Q1|Q2|Q3|Q4",
+				widthWide:        "第1四半期|第2四半期|第3四半期|第4四半期",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "午前|午後",
+			widthWide:        "午前|午後",
+			widthNarrow:      "午前|午後",
+		},
+		eras: map[string][numWidths]string{
+			"gregory":  {widthAbbreviated: "紀元前|西暦", widthWide: "紀元前|西暦", widthNarrow: "BC|AD"},
+			"buddhist": {widthAbbreviated: "仏暦", widthWide: "仏暦", widthNarrow: "BE"},
+			"roc":      {widthAbbreviated: "民国前|民国", widthWide: "民国前|民国", widthNarrow: "民国前|民国"},
+			"japanese": {widthAbbreviated: "明治|大正|昭和|平成|令和", widthWide: "明治|大正|昭和|平成|令和", widthNarrow: "M|T|S|H|R"},
+		},
+		dateFormats:        [numLengths]string{"y年M月d日EEEE", "y年M月d日", "y/MM/dd", "y/MM/dd"},
+		timeFormats:        [numLengths]string{"H時mm分ss秒 zzzz", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		dateTimeFormats:    [numLengths]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		genericDateFormats: [numLengths]string{"Gy年M月d日EEEE", "Gy年M月d日", "Gy年M月d日", "GGGGGy/M/d"},
+		availableFormats: map[string]string{
+			"d":       "d日",
+			"E":       "ccc",
+			"Ed":      "d日(E)",
+			"Ehm":     "aK:mm (E)",
+			"EHm":     "H:mm (E)",
+			"Ehms":    "aK:mm:ss (E)",
+			"EHms":    "H:mm:ss (E)",
+			"Gy":      "Gy年",
+			"GyMMM":   "Gy年M月",
+			"GyMMMd":  "Gy年M月d日",
+			"GyMMMEd": "Gy年M月d日(E)",
+			"h":       "aK時",
+			"H":       "H時",
+			"hm":      "aK:mm",
+			"Hm":      "H:mm",
+			"hms":     "aK:mm:ss",
+			"Hms":     "H:mm:ss",
+			"M":       "M月",
+			"Md":      "M/d",
+			"MEd":     "M/d(E)",
+			"MMM":     "M月",
+			"MMMd":    "M月d日",
+			"MMMEd":   "M月d日(E)",
+			"MMMMd":   "M月d日",
+			"ms":      "mm:ss",
+			"y":       "y年",
+			"yM":      "y/M",
+			"yMd":     "y/M/d",
+			"yMEd":    "y/M/d(E)",
+			"yMMM":    "y年M月",
+			"yMMMd":   "y年M月d日",
+			"yMMMEd":  "y年M月d日(E)",
+			"yMMMM":   "y年M月",
+			"yQQQ":    "y/QQQ",
+			"yQQQQ":   "y年QQQQ",
+		},
+	},
+	"zh": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "1月|2月|3月|4月|5月|6月|7月|8月|9月|10月|11月|12月",
+				widthWide:        "一月|二月|三月|四月|五月|六月|七月|八月|九月|十月|十一月|十二月",
+				widthNarrow:      "1|2|3|4|5|6|7|8|9|10|11|12",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "周日|周一|周二|周三|周四|周五|周六",
+				widthWide:        "星期日|星期一|星期二|星期三|星期四|星期五|星期六",
+				widthNarrow:      "日|一|二|三|四|五|六",
+				widthShort:       "周日|周一|周二|周三|周四|周五|周六",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "1季度|2季度|3季度|4季度",
+				widthWide:        "第一季度|第二季度|第三季度|第四季度",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "上午|下午",
+			widthWide:        "上午|下午",
+			widthNarrow:      "上午|下午",
+		},
+		eras: map[string][numWidths]string{
+			"gregory":  {widthAbbreviated: "公元前|公元", widthWide: "公元前|公元", widthNarrow: "公元前|公元"},
+			"buddhist": {widthAbbreviated: "佛历", widthWide: "佛历", widthNarrow: "佛历"},
+			"roc":      {widthAbbreviated: "民国前|民国", widthWide: "民国前|民国", widthNarrow: "民国前|民国"},
+			"japanese": {widthAbbreviated: "明治|大正|昭和|平成|令和", widthWide: "明治|大正|昭和|平成|令和", widthNarrow: "M|T|S|H|R"},
+		},
+		dateFormats:        [numLengths]string{"y年M月d日EEEE", "y年M月d日", "y年M月d日", "y/M/d"},
+		timeFormats:        [numLengths]string{"zzzz ah:mm:ss", "z ah:mm:ss", "ah:mm:ss", "ah:mm"},
+		dateTimeFormats:    [numLengths]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		genericDateFormats: [numLengths]string{"Gy年M月d日EEEE", "Gy年M月d日", "Gy年M月d日", "Gy/M/d"},
+		availableFormats: map[string]string{
+			"d":       "d日",
+			"E":       "ccc",
+			"Ed":      "d日E",
+			"Ehm":     "Eah:mm",
+			"EHm":     "EHH:mm",
+			"Ehms":    "Eah:mm:ss",
+			"EHms":    "EHH:mm:ss",
+			"Gy":      "Gy年",
+			"GyMMM":   "Gy年M月",
+			"GyMMMd":  "Gy年M月d日",
+			"GyMMMEd": "Gy年M月d日E",
+			"h":       "ah时",
+			"H":       "H时",
+			"hm":      "ah:mm",
+			"Hm":      "HH:mm",
+			"hms":     "ah:mm:ss",
+			"Hms":     "HH:mm:ss",
+			"M":       "M月",
+			"Md":      "M/d",
+			"MEd":     "M/dE",
+			"MMM":     "LLL",
+			"MMMd":    "M月d日",
+			"MMMEd":   "M月d日E",
+			"MMMMd":   "M月d日",
+			"ms":      "mm:ss",
+			"y":       "y年",
+			"yM":      "y年M月",
+			"yMd":     "y/M/d",
+			"yMEd":    "y/M/dE",
+			"yMMM":    "y年M月",
+			"yMMMd":   "y年M月d日",
+			"yMMMEd":  "y年M月d日E",
+			"yMMMM":   "y年M月",
+			"yQQQ":    "y年第Q季度",
+			"yQQQQ":   "y年第Q季度",
+		},
+	},
+	"ru": {
+		months: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "янв.|февр.|мар.|апр.|мая|июн.|июл.|авг.|сент.|окт.|нояб.|дек.",
+				widthWide:        "января|февраля|марта|апреля|мая|июня|июля|августа|сентября|октября|ноября|декабря",
+				widthNarrow:      "Я|Ф|М|А|М|И|И|А|С|О|Н|Д",
+			},
+			contextStandAlone: {
+				widthAbbreviated: "янв.|февр.|март|апр.|май|июнь|июль|авг.|сент.|окт.|нояб.|дек.",
+				widthWide:        "январь|февраль|март|апрель|май|июнь|июль|август|сентябрь|октябрь|ноябрь|декабрь",
+			},
+		},
+		days: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "вс|пн|вт|ср|чт|пт|сб",
+				widthWide:        "воскресенье|понедельник|вторник|среда|четверг|пятница|суббота",
+				widthNarrow:      "вс|пн|вт|ср|чт|пт|сб",
+				widthShort:       "вс|пн|вт|ср|чт|пт|сб",
+			},
+			contextStandAlone: {
+				widthNarrow: "В|П|В|С|Ч|П|С",
+			},
+		},
+		quarters: [numContexts][numWidths]string{
+			contextFormat: {
+				widthAbbreviated: "1-й кв.|2-й кв.|3-й кв.|4-й кв.",
+				widthWide:        "1-й квартал|2-й квартал|3-й квартал|4-й квартал",
+			},
+		},
+		dayPeriods: [numWidths]string{
+			widthAbbreviated: "AM|PM",
+			widthWide:        "AM|PM",
+			widthNarrow:      "AM|PM",
+		},
+		eras: map[string][numWidths]string{
+			"gregory": {widthAbbreviated: "до н. э.|н. э.", widthWide: "до Рождества Христова|от Рождества Христова", widthNarrow: "до н.э.|н.э."},
+		},
+		dateFormats:     [numLengths]string{"EEEE, d MMMM y 'г'.", "d MMMM y 'г'.", "d MMM y 'г'.", "dd.MM.y"},
+		timeFormats:     [numLengths]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [numLengths]string{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"},
+		availableFormats: map[string]string{
+			"d":       "d",
+			"E":       "ccc",
+			"Ed":      "ccc, d",
+			"Ehm":     "E h:mm a",
+			"EHm":     "E HH:mm",
+			"Ehms":    "E h:mm:ss a",
+			"EHms":    "E HH:mm:ss",
+			"Gy":      "y 'г'. G",
+			"GyMMM":   "LLL y G",
+			"GyMMMd":  "d MMM y 'г'. G",
+			"GyMMMEd": "E, d MMM y 'г'. G",
+			"h":       "h a",
+			"H":       "HH",
+			"hm":      "h:mm a",
+			"Hm":      "HH:mm",
+			"hms":     "h:mm:ss a",
+			"Hms":     "HH:mm:ss",
+			"M":       "L",
+			"Md":      "dd.MM",
+			"MEd":     "E, dd.MM",
+			"MMM":     "LLL",
+			"MMMd":    "d MMM",
+			"MMMEd":   "ccc, d MMM",
+			"MMMMd":   "d MMMM",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "MM.y",
+			"yMd":     "dd.MM.y",
+			"yMEd":    "ccc, dd.MM.y 'г'.",
+			"yMMM":    "LLL y 'г'.",
+			"yMMMd":   "d MMM y 'г'.",
+			"yMMMEd":  "E, d MMM y 'г'.",
+			"yMMMM":   "LLLL y 'г'.",
+			"yQQQ":    "QQQ y 'г'.",
+			"yQQQQ":   "QQQQ y 'г'.",
+		},
+	},
+}
+
+// hour12Regions lists the regions for which the preferred hour cycle is h12,
+// following the CLDR timeData. All other regions use h23.
+var hour12Regions = map[string]bool{
+	"AS": true, "AU": true, "BD": true, "CA": true, "CN": true, "CO": true,
+	"EG": true, "GU": true, "HK": true, "IN": true, "IQ": true, "JO": true,
+	"KR": true, "MO": true, "MP": true, "MX": true, "MY": true, "NZ": true,
+	"PH": true, "PK": true, "PR": true, "SA": true, "SG": true, "TW": true,
+	"UM": true, "US": true, "VI": true,
+}
+
+// firstDayRegions maps regions for which the first day of the week is not
+// Monday to that day, following the CLDR weekData.
+var firstDayRegions = map[string]int{
+	"AG": 0, "AS": 0, "AU": 0, "BD": 0, "BR": 0, "BS": 0, "BT": 0, "BW": 0,
+	"BZ": 0, "CA": 0, "CN": 0, "CO": 0, "DM": 0, "DO": 0, "ET": 0, "GT": 0,
+	"GU": 0, "HK": 0, "HN": 0, "ID": 0, "IL": 0, "IN": 0, "JM": 0, "JP": 0,
+	"KE": 0, "KH": 0, "KR": 0, "LA": 0, "MH": 0, "MM": 0, "MO": 0, "MT": 0,
+	"MX": 0, "MZ": 0, "NI": 0, "NP": 0, "PA": 0, "PE": 0, "PH": 0, "PK": 0,
+	"PR": 0, "PT": 0, "PY": 0, "SA": 0, "SG": 0, "SV": 0, "TH": 0, "TT": 0,
+	"TW": 0, "UM": 0, "US": 0, "VE": 0, "VI": 0, "WS": 0, "YE": 0, "ZA": 0,
+	"ZW": 0,
+	"AE": 6, "AF": 6, "BH": 6, "DJ": 6, "DZ": 6, "EG": 6, "IQ": 6, "IR": 6,
+	"JO": 6, "KW": 6, "LY": 6, "OM": 6, "QA": 6, "SD": 6, "SY": 6,
+}
+
+// minDays4Regions lists the regions for which the first week of the year must
+// have at least four days, following the CLDR weekData. All other regions
+// use a minimum of one day.
+var minDays4Regions = map[string]bool{
+	"AD": true, "AN": true, "AT": true, "AX": true, "BE": true, "BG": true,
+	"CH": true, "CZ": true, "DE": true, "DK": true, "EE": true, "ES": true,
+	"FI": true, "FJ": true, "FO": true, "FR": true, "GB": true, "GF": true,
+	"GG": true, "GI": true, "GP": true, "GR": true, "HU": true, "IE": true,
+	"IM": true, "IS": true, "IT": true, "JE": true, "LI": true, "LT": true,
+	"LU": true, "MC": true, "MQ": true, "NL": true, "NO": true, "PL": true,
+	"PT": true, "RE": true, "RU": true, "SE": true, "SJ": true, "SK": true,
+	"SM": true, "VA": true,
+}
@@ -0,0 +1,258 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package date formats dates and times in a language-specific way.
+//
+// Dates and times can be formatted using one of the standard lengths defined
+// by CLDR or by means of a skeleton. A skeleton lists the fields that should
+// be displayed, such as "yMMMd" for a year, abbreviated month, and day, and is
+// mapped to the best pattern available for a language.
+//
+// The calendar system and hour cycle are taken from the -u-ca and -u-hc
+// Unicode extensions of the language tag, if present, and can be overridden
+// with options.
+//
+// A Formatter can be passed as an argument to a message.Printer to be
+// formatted in the language of the printer:
+//
+//	p := message.NewPrinter(language.German)
+//	p.Printf("%v", date.Date(t, date.Long)) // 2. Januar 2006
+//
+// Values of type time.Time are formatted using DateTime with Medium lengths
+// by a message.Printer.
+//
+// Relative and RelativeDuration format times relative to the present, such as
+// "3 days ago" or "tomorrow".
+package date // import "golang.org/x/text/date"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/internal/format"
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// TODO:
+// - Localized time zone names.
+// - Support for non-Gregorian month names and lunisolar calendars.
+// - Flexible day periods (noon, in the morning, etc.).
+// - Interval formats.
+
+// A Length is one of the standard lengths of a date or time format.
+type Length int
+
+const (
+	// Full is the most verbose format, such as "Tuesday, April 12, 1952 AD"
+	// or "3:30:42 pm PST".
+	Full Length = iota
+
+	// Long is a verbose format, such as "January 12, 1952" or "3:30:32 pm".
+	Long
+
+	// Medium is an abbreviated format, such as "Jan 12, 1952".
+	Medium
+
+	// Short is a numeric format, such as "12/13/52" or "3:30 pm".
+	Short
+
+	numLengths
+)
+
+// An Option configures a Formatter.
+type Option func(o *options)
+
+type options struct {
+	calendar  string
+	hourCycle string
+	width     Width
+	numeric   bool
+}
+
+// Calendar sets the calendar system using a BCP 47 calendar type, such as
+// "gregory", "buddhist", "japanese", or "roc". It overrides the -u-ca key of
+// the language tag. Calendars that are not supported fall back to the
+// Gregorian calendar.
+func Calendar(id string) Option {
+	return func(o *options) { o.calendar = id }
+}
+
+// HourCycle sets the hour cycle using a BCP 47 hour cycle type, which is one of
+// "h11", "h12", "h23", or "h24". It overrides the -u-hc key of the language
+// tag.
+func HourCycle(hc string) Option {
+	return func(o *options) { o.hourCycle = hc }
+}
+
+type kind uint8
+
+const (
+	kindDate kind = iota
+	kindTime
+	kindDateTime
+	kindSkeleton
+	kindRelative
+)
+
+// A Formatter formats a time value. It implements fmt.Formatter.
+type Formatter struct {
+	t        time.Time
+	kind     kind
+	dateLen  Length
+	timeLen  Length
+	skeleton string
+	amount   float64
+	unit     Unit
+	options  []Option
+}
+
+// Date formats the date of t using the given standard length.
+func Date(t time.Time, length Length, opts ...Option) Formatter {
+	return Formatter{t: t, kind: kindDate, dateLen: length, options: opts}
+}
+
+// Time formats the time of day of t using the given standard length.
+func Time(t time.Time, length Length, opts ...Option) Formatter {
+	return Formatter{t: t, kind: kindTime, timeLen: length, options: opts}
+}
+
+// DateTime formats both the date and time of t using the given standard
+// lengths.
+func DateTime(t time.Time, date, time Length, opts ...Option) Formatter {
+	return Formatter{t: t, kind: kindDateTime, dateLen: date, timeLen: time, options: opts}
+}
+
+// Skeleton formats t using the pattern that best matches the given skeleton
+// for the language. A skeleton is a sequence of CLDR date field symbols, such
+// as "yMMMd" or "Ehm", without literals. The order of the fields is not
+// significant.
+//
+// The symbol 'j' requests the preferred hour format for the language. The
+// symbols 'J' and 'C' are currently treated as 'j'.
+func Skeleton(t time.Time, skeleton string, opts ...Option) Formatter {
+	return Formatter{t: t, kind: kindSkeleton, skeleton: skeleton, options: opts}
+}
+
+// Format implements fmt.Formatter. It formats the value in the language of s
+// if it implements format.State, and in the root language otherwise. Only the
+// verbs 'v' and 's' are supported.
+func (f Formatter) Format(s fmt.State, verb rune) {
+	if verb != 'v' && verb != 's' {
+		var v interface{} = f.t
+		if f.kind == kindRelative {
+			v = f.amount
+		}
+		fmt.Fprintf(s, "%%!%c(%T=%v)", verb, v, v)
+		return
+	}
+	lang := language.Und
+	if state, ok := s.(format.State); ok {
+		lang = state.Language()
+	}
+	var buf [64]byte
+	s.Write(f.AppendFormat(buf[:0], lang))
+}
+
+// AppendFormat appends the formatted value in language t to dst and returns
+// the extended buffer.
+func (f Formatter) AppendFormat(dst []byte, t language.Tag) []byte {
+	var o options
+	for _, opt := range f.options {
+		opt(&o)
+	}
+	if f.kind == kindRelative {
+		return f.appendRelative(dst, t, &o)
+	}
+	if o.calendar == "" {
+		o.calendar = t.TypeForKey("ca")
+	}
+	if o.hourCycle == "" {
+		o.hourCycle = t.TypeForKey("hc")
+	}
+	s := state{
+		t:      f.t,
+		loc:    lookupLocale(t),
+		cal:    parseCalendar(o.calendar),
+		digits: number.InfoFromTag(t),
+	}
+	pattern := f.pattern(s.loc, s.cal, hourLetter(o.hourCycle))
+	return s.appendPattern(dst, parsePattern(pattern))
+}
+
+// hourLetter returns the pattern letter for a BCP 47 hour cycle type or 0 if
+// the type is not valid.
+func hourLetter(hc string) byte {
+	switch hc {
+	case "h11":
+		return 'K'
+	case "h12":
+		return 'h'
+	case "h23":
+		return 'H'
+	case "h24":
+		return 'k'
+	}
+	return 0
+}
+
+// pattern returns the CLDR pattern for f. The hour letter, if not 0,
+// overrides the hour cycle of the locale.
+func (f *Formatter) pattern(l *locale, cal calendar, hour byte) string {
+	var date, time string
+	switch f.kind {
+	case kindDate, kindDateTime:
+		date = l.dateFormats[f.dateLen.clamp()]
+		if cal != gregorian {
+			date = l.genericDateFormats[f.dateLen.clamp()]
+		}
+		if f.kind == kindDate {
+			return date
+		}
+	case kindSkeleton:
+		return l.skeletonPattern(f.skeleton, cal, hour)
+	}
+	time = l.timeFormats[f.timeLen.clamp()]
+	if hour != 0 {
+		time = l.setHourCycle(time, hour)
+	}
+	if f.kind == kindTime {
+		return time
+	}
+	return combine(l.dateTimeFormats[f.dateLen.clamp()], date, time)
+}
+
+func (x Length) clamp() Length {
+	switch {
+	case x < Full:
+		return Full
+	case x > Short:
+		return Short
+	}
+	return x
+}
+
+// skeletonPattern returns the best pattern for skeleton s.
+func (l *locale) skeletonPattern(s string, cal calendar, hour byte) string {
+	if hour == 0 {
+		hour = 'H'
+		if l.hour12 {
+			hour = 'h'
+		}
+	}
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case 'j', 'J', 'C':
+			return rune(hour)
+		}
+		return r
+	}, s)
+	sk := parseSkeleton(s)
+	if cal != gregorian && sk[typeYear].count > 0 && sk[typeEra].count == 0 {
+		// Years of other calendars are ambiguous without an era.
+		sk[typeEra] = field{letter: 'G', count: 1}
+	}
+	return l.bestPattern(&sk)
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	pst    = time.FixedZone("PST", -8*3600)
+	ist    = time.FixedZone("", 5*3600+1800)
+	testTm = time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC)
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", Date(testTm, Full), "Monday, January 2, 2006"},
+		{"en", Date(testTm, Long), "January 2, 2006"},
+		{"en", Date(testTm, Medium), "Jan 2, 2006"},
+		{"en", Date(testTm, Short), "1/2/06"},
+		{"en", Time(testTm, Full), "3:04:05 PM GMT"},
+		{"en", Time(testTm, Long), "3:04:05 PM UTC"},
+		{"en", Time(testTm, Medium), "3:04:05 PM"},
+		{"en", Time(testTm, Short), "3:04 PM"},
+		{"en", DateTime(testTm, Full, Short), "Monday, January 2, 2006 at 3:04 PM"},
+		{"en", DateTime(testTm, Medium, Medium), "Jan 2, 2006, 3:04:05 PM"},
+		{"en-GB", Date(testTm, Short), "02/01/2006"},
+		{"en-GB", DateTime(testTm, Medium, Medium), "2 Jan 2006, 15:04:05"},
+		{"de", Date(testTm, Full), "Montag, 2. Januar 2006"},
+		{"de", Date(testTm, Short), "02.01.06"},
+		{"de-CH", Date(testTm, Long), "2. Januar 2006"},
+		{"fr", DateTime(testTm, Medium, Medium), "2 janv. 2006 à 15:04:05"},
+		{"fr", Time(testTm, Full), "15:04:05 UTC"},
+		{"es", Date(testTm, Full), "lunes, 2 de enero de 2006"},
+		{"it", Date(testTm, Full), "lunedì 2 gennaio 2006"},
+		{"nl", Date(testTm, Short), "02-01-2006"},
+		{"ja", Date(testTm, Full), "2006年1月2日月曜日"},
+		{"ja", Time(testTm, Full), "15時04分05秒 GMT"},
+		{"zh", Time(testTm, Medium), "下午3:04:05"},
+		{"ru", Date(testTm, Long), "2 января 2006 г."},
+		{"und", Date(testTm, Short), "2006-01-02"},
+
+		// Time zones.
+		{"en", Time(testTm.In(pst), Long), "7:04:05 AM PST"},
+		{"en", Time(testTm.In(pst), Full), "7:04:05 AM GMT-08:00"},
+		{"en", Time(testTm.In(ist), Long), "8:34:05 PM GMT+5:30"},
+		{"fr", Time(testTm.In(pst), Full), "07:04:05 UTC−08:00"},
+
+		// Hour cycles.
+		{"en-u-hc-h23", Time(testTm, Short), "15:04"},
+		{"en-u-hc-h23", DateTime(testTm, Medium, Medium), "Jan 2, 2006, 15:04:05"},
+		{"de-u-hc-h12", Time(testTm, Short), "3:04 nachm."},
+		{"en", Time(testTm, Short, HourCycle("h23")), "15:04"},
+		{"en-u-hc-h23", Time(testTm, Short, HourCycle("h12")), "3:04 PM"},
+		{"en", Time(testTm, Short, HourCycle("h11")), "3:04 PM"},
+		{"en", Time(testTm.Add(-15*time.Hour), Short, HourCycle("h11")), "0:04 AM"},
+		{"en", Time(testTm.Add(-15*time.Hour), Short, HourCycle("h24")), "24:04"},
+
+		// Calendars.
+		{"ja-u-ca-japanese", Date(testTm, Full), "平成18年1月2日月曜日"},
+		{"ja-u-ca-japanese", Date(testTm, Short), "H18/1/2"},
+		{"ja", Date(testTm, Long, Calendar("japanese")), "平成18年1月2日"},
+		{"ja-u-ca-japanese", Date(testTm, Long, Calendar("gregory")), "2006年1月2日"},
+		{"en", Date(time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), Medium, Calendar("japanese")), "May 1, 1 Reiwa"},
+		{"en-u-ca-buddhist", Date(testTm, Medium), "Jan 2, 2549 BE"},
+		{"en-u-ca-roc", Date(testTm, Long), "January 2, 95 Minguo"},
+		{"en-u-ca-islamic", Date(testTm, Medium), "Jan 2, 2006"}, // unsupported
+
+		// Numbering systems.
+		{"ar-u-nu-arab", Date(testTm, Short), "٢٠٠٦-٠١-٠٢"},
+
+		// Skeletons.
+		{"en", Skeleton(testTm, "yMMMd"), "Jan 2, 2006"},
+		{"en", Skeleton(testTm, "MMMMdy"), "January 2, 2006"},
+		{"en", Skeleton(testTm, "yMMMMEEEEd"), "Monday, January 2, 2006"},
+		{"en", Skeleton(testTm, "jm"), "3:04 PM"},
+		{"en", Skeleton(testTm, "Hm"), "15:04"},
+		{"en", Skeleton(testTm, "jmz"), "3:04 PM UTC"},
+		{"en", Skeleton(testTm, "yMMMdjms"), "Jan 2, 2006, 3:04:05 PM"},
+		{"en", Skeleton(testTm, "yMMMMEEEEdjm"), "Monday, January 2, 2006 at 3:04 PM"},
+		{"en", Skeleton(testTm, "yMMMd", Calendar("buddhist")), "Jan 2, 2549 BE"},
+		{"en-GB", Skeleton(testTm, "jm"), "15:04"},
+		{"en-u-hc-h23", Skeleton(testTm, "jm"), "15:04"},
+		{"de", Skeleton(testTm, "yMMMd"), "2. Jan. 2006"},
+		{"de", Skeleton(testTm, "MMMMd"), "2. Januar"},
+		{"fr", Skeleton(testTm, "yMMMMd"), "2 janvier 2006"},
+		{"ja", Skeleton(testTm, "yMMMd"), "2006年1月2日"},
+		{"ja-u-ca-japanese", Skeleton(testTm, "yMMMd"), "平成18年1月2日"},
+		{"zh", Skeleton(testTm, "jm"), "下午3:04"},
+		{"en", Skeleton(testTm, "yw"), "2006 1"},
+		{"en", Skeleton(testTm, "jmsSSS"), "3:04:05 PM 123"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			tag := language.MustParse(tc.tag)
+			if got := string(tc.f.AppendFormat(nil, tag)); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFmt(t *testing.T) {
+	testCases := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "2006-01-02"},
+		{"%s", "2006-01-02"},
+		{"%d", "%!d(time.Time=2006-01-02 15:04:05.123456789 +0000 UTC)"},
+	}
+	for _, tc := range testCases {
+		if got := fmt.Sprintf(tc.format, Date(testTm, Short)); got != tc.want {
+			t.Errorf("%s: got %q; want %q", tc.format, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"time"
+
+	"golang.org/x/text/date"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Example() {
+	t := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	for _, tag := range []string{"en", "de", "ja-u-ca-japanese", "en-u-hc-h23"} {
+		p := message.NewPrinter(language.MustParse(tag))
+		p.Printf("%v | %v | %v\n",
+			date.Date(t, date.Long),
+			date.Time(t, date.Short),
+			date.Skeleton(t, "yMMMEd"))
+	}
+	// Output:
+	// January 2, 2006 | 3:04 PM | Mon, Jan 2, 2006
+	// 2. Januar 2006 | 15:04 | Mo., 2. Jan. 2006
+	// 平成18年1月2日 | 15:04 | 平成18年1月2日(月)
+	// January 2, 2006 | 15:04 | Mon, Jan 2, 2006
+}
+
+func ExampleSkeleton() {
+	t := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	p := message.NewPrinter(language.BritishEnglish)
+	p.Println(date.Skeleton(t, "MMMMd"))
+	p.Println(date.Skeleton(t, "jm"))
+	p.Println(date.Skeleton(t, "jm", date.HourCycle("h12")))
+	// Output:
+	// 2 January
+	// 15:04
+	// 3:04 pm
+}
+
+func ExampleDateTime() {
+	t := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	p := message.NewPrinter(language.French)
+	p.Println(date.DateTime(t, date.Full, date.Short))
+	p.Println(t) // time.Time values use medium lengths.
+	// Output:
+	// lundi 2 janvier 2006 à 15:04
+	// 2 janv. 2006 à 15:04:05
+}
+
+func ExampleRelative() {
+	p := message.NewPrinter(language.English)
+	p.Println(date.Relative(-1, date.Day))
+	p.Println(date.Relative(-1, date.Day, date.Numeric()))
+	p.Println(date.Relative(3, date.Month, date.UnitWidth(date.Abbreviated)))
+	p.Println(date.RelativeDuration(-90 * time.Minute))
+
+	p = message.NewPrinter(language.German)
+	p.Println(date.Relative(2, date.Day))
+	p.Println(date.RelativeDuration(-5 * time.Minute))
+	// Output:
+	// yesterday
+	// 1 day ago
+	// in 3 mo.
+	// 1 hour ago
+	// übermorgen
+	// vor 5 Minuten
+}
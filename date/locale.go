@@ -0,0 +1,164 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// A locale holds the fully resolved calendar data for a language, including
+// all data inherited from its parents.
+type locale struct {
+	months     [numContexts][numWidths][]string
+	days       [numContexts][numWidths][]string
+	quarters   [numContexts][numWidths][]string
+	dayPeriods [numWidths][]string
+	eras       map[string][numWidths][]string
+
+	dateFormats        [numLengths]string
+	timeFormats        [numLengths]string
+	dateTimeFormats    [numLengths]string
+	genericDateFormats [numLengths]string
+	availableFormats   map[string]string
+
+	gmtFormat     string
+	gmtZeroFormat string
+	hourFormat    string
+
+	// hour12 indicates whether the preferred hour cycle is h12.
+	hour12 bool
+	// firstDay is the first day of the week, where 0 is Sunday.
+	firstDay int
+	// minDays is the minimum number of days in the first week of a year.
+	minDays int
+}
+
+var localeCache sync.Map // map[language.Tag]*locale
+
+// lookupLocale returns the resolved locale data for the given tag. Extensions
+// of the tag are ignored.
+func lookupLocale(t language.Tag) *locale {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	if l, ok := localeCache.Load(key); ok {
+		return l.(*locale)
+	}
+	l := resolve(key)
+	// Use the likely region if no region is specified.
+	region, _ := key.Region()
+	l.hour12 = hour12Regions[region.String()]
+	l.firstDay = 1
+	if d, ok := firstDayRegions[region.String()]; ok {
+		l.firstDay = d
+	}
+	l.minDays = 1
+	if minDays4Regions[region.String()] {
+		l.minDays = 4
+	}
+	localeCache.Store(key, l)
+	return l
+}
+
+// resolve merges the data of t and all its parents.
+func resolve(t language.Tag) *locale {
+	var chain []*localeData
+	for ; ; t = t.Parent() {
+		if d, ok := locales[t.String()]; ok {
+			chain = append(chain, d)
+		}
+		if t.IsRoot() {
+			break
+		}
+	}
+	l := &locale{
+		eras:             map[string][numWidths][]string{},
+		availableFormats: map[string]string{},
+	}
+	// Apply data from the root down so that children override parents.
+	for i := len(chain) - 1; i >= 0; i-- {
+		d := chain[i]
+		for c := 0; c < numContexts; c++ {
+			mergeNames(&l.months[c], &d.months[c])
+			mergeNames(&l.days[c], &d.days[c])
+			mergeNames(&l.quarters[c], &d.quarters[c])
+		}
+		mergeNames(&l.dayPeriods, &d.dayPeriods)
+		for cal, e := range d.eras {
+			x := l.eras[cal]
+			mergeNames(&x, &e)
+			l.eras[cal] = x
+		}
+		mergeStrings(l.dateFormats[:], d.dateFormats[:])
+		mergeStrings(l.timeFormats[:], d.timeFormats[:])
+		mergeStrings(l.dateTimeFormats[:], d.dateTimeFormats[:])
+		mergeStrings(l.genericDateFormats[:], d.genericDateFormats[:])
+		for k, v := range d.availableFormats {
+			l.availableFormats[k] = v
+		}
+		mergeString(&l.gmtFormat, d.gmtFormat)
+		mergeString(&l.gmtZeroFormat, d.gmtZeroFormat)
+		mergeString(&l.hourFormat, d.hourFormat)
+	}
+	return l
+}
+
+func mergeNames(dst *[numWidths][]string, src *[numWidths]string) {
+	for i, s := range src {
+		if s != "" {
+			dst[i] = strings.Split(s, "|")
+		}
+	}
+}
+
+func mergeStrings(dst, src []string) {
+	for i, s := range src {
+		mergeString(&dst[i], s)
+	}
+}
+
+func mergeString(dst *string, src string) {
+	if src != "" {
+		*dst = src
+	}
+}
+
+// names returns the list of names for the given context and width, applying
+// the CLDR fallback rules between contexts and widths.
+func names(list *[numContexts][numWidths][]string, context, width int) []string {
+	if x := list[context][width]; x != nil {
+		return x
+	}
+	if context == contextStandAlone {
+		// Stand-alone names fall back to format names of the same width.
+		if x := list[contextFormat][width]; x != nil {
+			return x
+		}
+	}
+	if width != widthAbbreviated {
+		return names(list, context, widthAbbreviated)
+	}
+	return list[contextFormat][widthAbbreviated]
+}
+
+// eraNames returns the era names for the given calendar.
+func (l *locale) eraNames(cal calendar, width int) []string {
+	e := l.eras[cal.String()]
+	if x := e[width]; x != nil {
+		return x
+	}
+	return e[widthAbbreviated]
+}
+
+// dayPeriod returns the name of the day period for width.
+func (l *locale) dayPeriod(width, i int) string {
+	x := l.dayPeriods[width]
+	if x == nil {
+		x = l.dayPeriods[widthAbbreviated]
+	}
+	return x[i]
+}
@@ -0,0 +1,380 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/internal/number"
+)
+
+// This file contains an interpreter for the CLDR date format patterns as
+// described in https://unicode.org/reports/tr35/tr35-dates.html#Date_Format_Patterns.
+
+// A field is an element of a parsed pattern. It is either a literal string or
+// a pattern field, in which case the letter is repeated count times.
+type field struct {
+	letter  byte // 0 for literals
+	count   int
+	literal string
+}
+
+// parsePattern splits a CLDR date pattern in literals and fields.
+func parsePattern(pat string) []field {
+	var fields []field
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			fields = append(fields, field{literal: lit.String()})
+			lit.Reset()
+		}
+	}
+	for i := 0; i < len(pat); {
+		c := pat[i]
+		switch {
+		case c == '\'':
+			i++
+			if i < len(pat) && pat[i] == '\'' {
+				// Escaped quote.
+				lit.WriteByte('\'')
+				i++
+				break
+			}
+			for i < len(pat) {
+				if pat[i] == '\'' {
+					if i+1 < len(pat) && pat[i+1] == '\'' {
+						lit.WriteByte('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				lit.WriteByte(pat[i])
+				i++
+			}
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+			flush()
+			n := 1
+			for i+n < len(pat) && pat[i+n] == c {
+				n++
+			}
+			fields = append(fields, field{letter: c, count: n})
+			i += n
+		default:
+			lit.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return fields
+}
+
+// A state holds the information needed to format a single time value.
+type state struct {
+	t      time.Time
+	loc    *locale
+	cal    calendar
+	digits number.Info
+}
+
+func (s *state) appendPattern(dst []byte, fields []field) []byte {
+	for _, f := range fields {
+		if f.letter == 0 {
+			dst = append(dst, f.literal...)
+			continue
+		}
+		dst = s.appendField(dst, f.letter, f.count)
+	}
+	return dst
+}
+
+// appendNumber appends x with at least min digits using the digits of the
+// numbering system.
+func (s *state) appendNumber(dst []byte, x, min int) []byte {
+	var buf [20]byte
+	b := strconv.AppendInt(buf[:0], int64(x), 10)
+	for i := len(b); i < min; i++ {
+		dst = s.digits.AppendDigit(dst, 0)
+	}
+	for _, c := range b {
+		if c == '-' {
+			dst = append(dst, '-')
+			continue
+		}
+		dst = s.digits.AppendDigit(dst, c-'0')
+	}
+	return dst
+}
+
+// textWidth returns the name width for a field count of a text field.
+func textWidth(count int) int {
+	switch count {
+	case 4:
+		return widthWide
+	case 5:
+		return widthNarrow
+	case 6:
+		return widthShort
+	}
+	return widthAbbreviated
+}
+
+func (s *state) appendField(dst []byte, letter byte, count int) []byte {
+	t := s.t
+	l := s.loc
+	switch letter {
+	case 'G': // era
+		cal, era, _ := s.cal.eraYear(t)
+		w := textWidth(count)
+		if w == widthShort {
+			w = widthAbbreviated
+		}
+		if e := l.eraNames(cal, w); era < len(e) {
+			return append(dst, e[era]...)
+		}
+		return s.appendNumber(dst, era, 1)
+	case 'y', 'Y', 'u':
+		var year int
+		switch letter {
+		case 'y':
+			_, _, year = s.cal.eraYear(t)
+		case 'Y':
+			year, _ = weekOfYear(t, l.firstDay, l.minDays)
+			if s.cal != gregorian {
+				_, _, y := s.cal.eraYear(t)
+				year += y - t.Year()
+			}
+		case 'u':
+			year = t.Year()
+		}
+		if count == 2 {
+			return s.appendNumber(dst, year%100, 2)
+		}
+		return s.appendNumber(dst, year, count)
+	case 'Q', 'q': // quarter
+		q := (int(t.Month()) - 1) / 3
+		if count <= 2 {
+			return s.appendNumber(dst, q+1, count)
+		}
+		context := contextFormat
+		if letter == 'q' {
+			context = contextStandAlone
+		}
+		return append(dst, names(&l.quarters, context, textWidth(count))[q]...)
+	case 'M', 'L': // month
+		m := int(t.Month()) - 1
+		if count <= 2 {
+			return s.appendNumber(dst, m+1, count)
+		}
+		context := contextFormat
+		if letter == 'L' {
+			context = contextStandAlone
+		}
+		return append(dst, names(&l.months, context, textWidth(count))[m]...)
+	case 'w': // week of year
+		_, w := weekOfYear(t, l.firstDay, l.minDays)
+		return s.appendNumber(dst, w, count)
+	case 'W': // week of month
+		first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		n := (int(first.Weekday()) - l.firstDay + 7) % 7
+		w := (t.Day() - 1 + n) / 7
+		if 7-n >= l.minDays {
+			w++
+		}
+		return s.appendNumber(dst, w, count)
+	case 'd':
+		return s.appendNumber(dst, t.Day(), count)
+	case 'D':
+		return s.appendNumber(dst, t.YearDay(), count)
+	case 'F': // day of week in month
+		return s.appendNumber(dst, (t.Day()-1)/7+1, count)
+	case 'g': // modified Julian day
+		days := t.Unix()/86400 + 40587
+		if t.Unix() < 0 && t.Unix()%86400 != 0 {
+			days--
+		}
+		return s.appendNumber(dst, int(days), count)
+	case 'E', 'e', 'c': // day of week
+		d := int(t.Weekday())
+		if letter != 'E' && count <= 2 {
+			// Local day of week, where the first day is 1.
+			return s.appendNumber(dst, (d-l.firstDay+7)%7+1, count)
+		}
+		context := contextFormat
+		if letter == 'c' {
+			context = contextStandAlone
+		}
+		return append(dst, names(&l.days, context, textWidth(count))[d]...)
+	case 'a', 'b', 'B': // period
+		pm := 0
+		if t.Hour() >= 12 {
+			pm = 1
+		}
+		w := textWidth(count)
+		if w == widthShort {
+			w = widthAbbreviated
+		}
+		return append(dst, l.dayPeriod(w, pm)...)
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return s.appendNumber(dst, h, count)
+	case 'H':
+		return s.appendNumber(dst, t.Hour(), count)
+	case 'K':
+		return s.appendNumber(dst, t.Hour()%12, count)
+	case 'k':
+		h := t.Hour()
+		if h == 0 {
+			h = 24
+		}
+		return s.appendNumber(dst, h, count)
+	case 'm':
+		return s.appendNumber(dst, t.Minute(), count)
+	case 's':
+		return s.appendNumber(dst, t.Second(), count)
+	case 'S': // fractional seconds, truncated
+		ns := t.Nanosecond()
+		for i := 0; i < count; i++ {
+			d := 0
+			if i < 9 {
+				ns *= 10
+				d = ns / 1e9
+				ns %= 1e9
+			}
+			dst = s.digits.AppendDigit(dst, byte(d))
+		}
+		return dst
+	case 'A': // milliseconds in day
+		ms := ((t.Hour()*60+t.Minute())*60+t.Second())*1000 + t.Nanosecond()/1e6
+		return s.appendNumber(dst, ms, count)
+	case 'z', 'Z', 'O', 'v', 'V', 'X', 'x':
+		return s.appendZone(dst, letter, count)
+	}
+	// Unknown pattern letters are rendered as is.
+	for i := 0; i < count; i++ {
+		dst = append(dst, letter)
+	}
+	return dst
+}
+
+// appendZone appends a representation of the time zone of t. Localized
+// zone names are not supported; zones are represented by their abbreviation
+// or a localized GMT format instead.
+func (s *state) appendZone(dst []byte, letter byte, count int) []byte {
+	name, offset := s.t.Zone()
+	switch letter {
+	case 'z', 'v', 'V':
+		if count < 4 && isAlpha(name) {
+			return append(dst, name...)
+		}
+		return s.appendGMT(dst, offset, count >= 4 || letter == 'V')
+	case 'O':
+		return s.appendGMT(dst, offset, count == 4)
+	case 'Z':
+		switch {
+		case count <= 3:
+			return appendISO(dst, offset, false, false, true)
+		case count == 4:
+			return s.appendGMT(dst, offset, true)
+		}
+		return appendISO(dst, offset, true, true, true)
+	case 'X', 'x':
+		zulu := letter == 'X'
+		switch count {
+		case 1:
+			return appendISO(dst, offset, zulu, false, false)
+		case 2, 4:
+			return appendISO(dst, offset, zulu, false, true)
+		}
+		return appendISO(dst, offset, zulu, true, true)
+	}
+	return dst
+}
+
+func isAlpha(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !('A' <= r && r <= 'Z' || 'a' <= r && r <= 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// appendGMT appends offset in the localized GMT format. The long format always
+// includes minutes.
+func (s *state) appendGMT(dst []byte, offset int, long bool) []byte {
+	if offset == 0 {
+		return append(dst, s.loc.gmtZeroFormat...)
+	}
+	format := s.loc.gmtFormat
+	i := strings.Index(format, "{0}")
+	if i < 0 {
+		return append(dst, format...)
+	}
+	dst = append(dst, format[:i]...)
+	// hourFormat holds a positive and negative pattern.
+	pat := s.loc.hourFormat
+	if p := strings.IndexByte(pat, ';'); p >= 0 {
+		if offset < 0 {
+			pat = pat[p+1:]
+		} else {
+			pat = pat[:p]
+		}
+	}
+	if offset < 0 {
+		offset = -offset
+	}
+	h, m := offset/3600, offset/60%60
+	for _, f := range parsePattern(pat) {
+		switch f.letter {
+		case 0:
+			if !long && m == 0 && strings.Contains(f.literal, ":") {
+				continue
+			}
+			dst = append(dst, f.literal...)
+		case 'H':
+			if long {
+				dst = s.appendNumber(dst, h, f.count)
+			} else {
+				dst = s.appendNumber(dst, h, 1)
+			}
+		case 'm':
+			if long || m != 0 {
+				dst = s.appendNumber(dst, m, f.count)
+			}
+		}
+	}
+	return append(dst, format[i+len("{0}"):]...)
+}
+
+// appendISO appends offset in ISO 8601 format.
+func appendISO(dst []byte, offset int, zulu, colon, minutes bool) []byte {
+	if offset == 0 && zulu {
+		return append(dst, 'Z')
+	}
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	h, m := offset/3600, offset/60%60
+	dst = append(dst, sign, byte('0'+h/10), byte('0'+h%10))
+	if minutes || m != 0 {
+		if colon {
+			dst = append(dst, ':')
+		}
+		dst = append(dst, byte('0'+m/10), byte('0'+m%10))
+	}
+	return dst
+}
@@ -0,0 +1,223 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A Unit is a unit of time used for relative time formatting.
+type Unit int
+
+const (
+	Second Unit = iota
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Quarter
+	Year
+
+	numUnits
+)
+
+var unitNames = []string{
+	"second", "minute", "hour", "day", "week", "month", "quarter", "year",
+}
+
+func (u Unit) String() string {
+	if u < 0 || u >= numUnits {
+		return "Unit(" + strconv.Itoa(int(u)) + ")"
+	}
+	return unitNames[u]
+}
+
+// A Width determines the length of the unit names of a relative time.
+type Width int
+
+const (
+	// Wide uses full unit names, such as "in 3 months".
+	Wide Width = iota
+
+	// Abbreviated uses abbreviated unit names, such as "in 3 mo.".
+	Abbreviated
+
+	// Narrow uses the shortest available unit names.
+	Narrow
+
+	numRelativeWidths
+)
+
+// UnitWidth sets the width of the unit names of a relative time. The default
+// is Wide.
+func UnitWidth(w Width) Option {
+	return func(o *options) { o.width = w }
+}
+
+// Numeric formats relative times always using a number, such as "in 1 day",
+// rather than a phrase, such as "tomorrow".
+func Numeric() Option {
+	return func(o *options) { o.numeric = true }
+}
+
+// Relative formats an amount of time units relative to the present. Negative
+// amounts denote the past and positive amounts the future. By default,
+// phrases such as "yesterday" and "next month" are used where available for
+// whole amounts.
+func Relative(amount float64, u Unit, opts ...Option) Formatter {
+	return Formatter{kind: kindRelative, amount: amount, unit: u, options: opts}
+}
+
+// RelativeDuration formats d relative to the present, using the largest unit
+// of which d holds at least one. Weeks, months, and years are approximated
+// as 7, 30, and 365 days, respectively. The amount is truncated to a whole
+// number of units. Negative durations denote the past.
+func RelativeDuration(d time.Duration, opts ...Option) Formatter {
+	u, amount := durationUnit(d)
+	return Relative(amount, u, opts...)
+}
+
+const day = 24 * time.Hour
+
+var unitDurations = []time.Duration{
+	Second: time.Second,
+	Minute: time.Minute,
+	Hour:   time.Hour,
+	Day:    day,
+	Week:   7 * day,
+	Month:  30 * day,
+	Year:   365 * day,
+}
+
+func durationUnit(d time.Duration) (Unit, float64) {
+	abs := d
+	if abs < 0 {
+		abs = -abs
+	}
+	u := Second
+	for i := Year; i > Second; i-- {
+		if x := unitDurations[i]; x != 0 && abs >= x {
+			u = i
+			break
+		}
+	}
+	return u, float64(d / unitDurations[u])
+}
+
+// appendRelative appends the relative time of f in language t to dst.
+func (f *Formatter) appendRelative(dst []byte, t language.Tag, o *options) []byte {
+	u := f.unit
+	if u < 0 || u >= numUnits {
+		u = Second
+	}
+	fields := lookupRelative(t, u, o.width)
+	amount := f.amount
+	if !o.numeric && amount == math.Trunc(amount) && math.Abs(amount) <= 2 {
+		if s, ok := fields.phrase(int(amount)); ok {
+			return append(dst, s...)
+		}
+	}
+	patterns := fields.future
+	if amount < 0 {
+		patterns = fields.past
+		amount = -amount
+	}
+
+	var nf number.Formatter
+	nf.InitDecimal(t)
+	var d number.Decimal
+	d.Convert(nf.RoundingContext, amount)
+	digits := number.FormatDigits(&d, nf.RoundingContext)
+	form := plural.Cardinal.MatchDigits(t, digits.Digits, int(digits.Exp), digits.NumFracDigits())
+
+	pattern := selectForm(patterns, form)
+	i := strings.Index(pattern, "{0}")
+	if i < 0 {
+		return append(dst, pattern...)
+	}
+	dst = append(dst, pattern[:i]...)
+	dst = nf.Render(dst, digits)
+	return append(dst, pattern[i+len("{0}"):]...)
+}
+
+var relativeCache sync.Map // map[language.Tag]*relativeUnits
+
+// lookupRelative returns the relative time data for the given language, unit,
+// and width. Missing widths fall back to wider ones.
+func lookupRelative(t language.Tag, u Unit, w Width) *relativeFields {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	var units *relativeUnits
+	if x, ok := relativeCache.Load(key); ok {
+		units = x.(*relativeUnits)
+	} else {
+		for p := key; ; p = p.Parent() {
+			if d, ok := relativeLocales[p.String()]; ok {
+				units = d
+				break
+			}
+			if p.IsRoot() {
+				units = relativeLocales["und"]
+				break
+			}
+		}
+		relativeCache.Store(key, units)
+	}
+	if w < Wide || w >= numRelativeWidths {
+		w = Wide
+	}
+	for ; w > Wide && units[u][w].future == ""; w-- {
+	}
+	return &units[u][w]
+}
+
+// phrase returns the phrase for the given offset, such as "tomorrow" for 1 day.
+func (f *relativeFields) phrase(offset int) (string, bool) {
+	prefix := strconv.Itoa(offset) + "="
+	for _, s := range strings.Split(f.relative, "|") {
+		if strings.HasPrefix(s, prefix) {
+			return s[len(prefix):], true
+		}
+	}
+	return "", false
+}
+
+var pluralForms = map[string]plural.Form{
+	"other": plural.Other,
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+}
+
+// selectForm selects the pattern for the given plural form from a list of
+// patterns of the form "one=in {0} day|other=in {0} days", falling back to
+// the pattern for other.
+func selectForm(patterns string, form plural.Form) string {
+	other := ""
+	for _, s := range strings.Split(patterns, "|") {
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			continue
+		}
+		switch f := pluralForms[s[:i]]; f {
+		case form:
+			return s[i+1:]
+		case plural.Other:
+			other = s[i+1:]
+		}
+	}
+	return other
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestRelative(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", Relative(3, Day), "in 3 days"},
+		{"en", Relative(-3, Day), "3 days ago"},
+		{"en", Relative(1, Day), "tomorrow"},
+		{"en", Relative(-1, Day), "yesterday"},
+		{"en", Relative(0, Day), "today"},
+		{"en", Relative(2, Day), "in 2 days"},
+		{"en", Relative(1, Day, Numeric()), "in 1 day"},
+		{"en", Relative(-1, Day, Numeric()), "1 day ago"},
+		{"en", Relative(0, Second), "now"},
+		{"en", Relative(0, Second, Numeric()), "in 0 seconds"},
+		{"en", Relative(1, Hour), "in 1 hour"},
+		{"en", Relative(1.5, Hour), "in 1.5 hours"},
+		{"en", Relative(-1, Year), "last year"},
+		{"en", Relative(-1, Year, UnitWidth(Abbreviated)), "last yr."},
+		{"en", Relative(-5, Year, UnitWidth(Abbreviated)), "5 yr. ago"},
+		{"en", Relative(-5, Year, UnitWidth(Narrow)), "5 yr. ago"},
+		{"en", Relative(2, Quarter, UnitWidth(Abbreviated)), "in 2 qtrs."},
+		{"en", Relative(1234, Month), "in 1,234 months"},
+		{"en-GB", Relative(-2, Week), "2 weeks ago"},
+		{"de", Relative(2, Day), "übermorgen"},
+		{"de", Relative(-2, Day), "vorgestern"},
+		{"de", Relative(-3, Day), "vor 3 Tagen"},
+		{"de", Relative(-1, Day, Numeric()), "vor 1 Tag"},
+		{"de", Relative(1.5, Hour), "in 1,5 Stunden"},
+		{"fr", Relative(1, Hour), "dans 1 heure"},
+		{"fr", Relative(-3, Month, UnitWidth(Abbreviated)), "il y a 3 m."},
+		{"es", Relative(-1, Day), "ayer"},
+		{"ja", Relative(3, Day), "3 日後"},
+		{"zh", Relative(-3, Minute), "3分钟前"},
+		{"ru", Relative(1, Day, Numeric()), "через 1 день"},
+		{"ru", Relative(3, Day), "через 3 дня"},
+		{"ru", Relative(5, Day), "через 5 дней"},
+		{"ru", Relative(21, Day), "через 21 день"},
+		{"ru", Relative(-1.5, Day), "1,5 дня назад"},
+		{"und", Relative(3, Day), "+3 d"},
+		{"ar-u-nu-arab", Relative(3, Day), "+٣ d"},
+
+		// Durations.
+		{"en", RelativeDuration(0), "now"},
+		{"en", RelativeDuration(30 * time.Second), "in 30 seconds"},
+		{"en", RelativeDuration(-5 * time.Minute), "5 minutes ago"},
+		{"en", RelativeDuration(-90 * time.Minute), "1 hour ago"},
+		{"en", RelativeDuration(-25 * time.Hour), "yesterday"},
+		{"en", RelativeDuration(-25*time.Hour, Numeric()), "1 day ago"},
+		{"en", RelativeDuration(15 * day), "in 2 weeks"},
+		{"en", RelativeDuration(-65 * day), "2 months ago"},
+		{"en", RelativeDuration(800 * day), "in 2 years"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			tag := language.MustParse(tc.tag)
+			if got := string(tc.f.AppendFormat(nil, tag)); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDurationUnit(t *testing.T) {
+	testCases := []struct {
+		d      time.Duration
+		unit   Unit
+		amount float64
+	}{
+		{0, Second, 0},
+		{999 * time.Millisecond, Second, 0},
+		{-59 * time.Second, Second, -59},
+		{time.Minute, Minute, 1},
+		{-61 * time.Minute, Hour, -1},
+		{6 * day, Day, 6},
+		{29 * day, Week, 4},
+		{364 * day, Month, 12},
+		{-730 * day, Year, -2},
+	}
+	for _, tc := range testCases {
+		u, amount := durationUnit(tc.d)
+		if u != tc.unit || amount != tc.amount {
+			t.Errorf("durationUnit(%v) = %v, %v; want %v, %v", tc.d, u, amount, tc.unit, tc.amount)
+		}
+	}
+}
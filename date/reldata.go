@@ -0,0 +1,296 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+// This file contains the CLDR relative time data for the locales supported by
+// this package. The data is derived from the "relative" and "relativeTime"
+// elements of the CLDR 32 "fields" section. Narrow widths are omitted where
+// they are identical to the abbreviated width.
+
+// relativeFields holds the relative time data for a single unit and width.
+type relativeFields struct {
+	// relative lists phrases for specific offsets, such as
+	// "-1=yesterday|0=today|1=tomorrow".
+	relative string
+
+	// future and past hold a pattern for each plural form, such as
+	// "one=in {0} day|other=in {0} days".
+	future, past string
+}
+
+type relativeUnits [numUnits][numRelativeWidths]relativeFields
+
+var relativeLocales = map[string]*relativeUnits{
+	"und": {
+		Second:  {{"0=now", "other=+{0} s", "other=-{0} s"}},
+		Minute:  {{"", "other=+{0} min", "other=-{0} min"}},
+		Hour:    {{"", "other=+{0} h", "other=-{0} h"}},
+		Day:     {{"-1=yesterday|0=today|1=tomorrow", "other=+{0} d", "other=-{0} d"}},
+		Week:    {{"-1=last week|0=this week|1=next week", "other=+{0} w", "other=-{0} w"}},
+		Month:   {{"-1=last month|0=this month|1=next month", "other=+{0} m", "other=-{0} m"}},
+		Quarter: {{"-1=last quarter|0=this quarter|1=next quarter", "other=+{0} Q", "other=-{0} Q"}},
+		Year:    {{"-1=last year|0=this year|1=next year", "other=+{0} y", "other=-{0} y"}},
+	},
+	"en": {
+		Second: {
+			{"0=now", "one=in {0} second|other=in {0} seconds", "one={0} second ago|other={0} seconds ago"},
+			{"0=now", "other=in {0} sec.", "other={0} sec. ago"},
+		},
+		Minute: {
+			{"0=this minute", "one=in {0} minute|other=in {0} minutes", "one={0} minute ago|other={0} minutes ago"},
+			{"0=this minute", "other=in {0} min.", "other={0} min. ago"},
+		},
+		Hour: {
+			{"0=this hour", "one=in {0} hour|other=in {0} hours", "one={0} hour ago|other={0} hours ago"},
+			{"0=this hour", "other=in {0} hr.", "other={0} hr. ago"},
+		},
+		Day: {
+			{"-1=yesterday|0=today|1=tomorrow", "one=in {0} day|other=in {0} days", "one={0} day ago|other={0} days ago"},
+			{"-1=yesterday|0=today|1=tomorrow", "one=in {0} day|other=in {0} days", "one={0} day ago|other={0} days ago"},
+		},
+		Week: {
+			{"-1=last week|0=this week|1=next week", "one=in {0} week|other=in {0} weeks", "one={0} week ago|other={0} weeks ago"},
+			{"-1=last wk.|0=this wk.|1=next wk.", "other=in {0} wk.", "other={0} wk. ago"},
+		},
+		Month: {
+			{"-1=last month|0=this month|1=next month", "one=in {0} month|other=in {0} months", "one={0} month ago|other={0} months ago"},
+			{"-1=last mo.|0=this mo.|1=next mo.", "other=in {0} mo.", "other={0} mo. ago"},
+		},
+		Quarter: {
+			{"-1=last quarter|0=this quarter|1=next quarter", "one=in {0} quarter|other=in {0} quarters", "one={0} quarter ago|other={0} quarters ago"},
+			{"-1=last qtr.|0=this qtr.|1=next qtr.", "one=in {0} qtr.|other=in {0} qtrs.", "one={0} qtr. ago|other={0} qtrs. ago"},
+		},
+		Year: {
+			{"-1=last year|0=this year|1=next year", "one=in {0} year|other=in {0} years", "one={0} year ago|other={0} years ago"},
+			{"-1=last yr.|0=this yr.|1=next yr.", "other=in {0} yr.", "other={0} yr. ago"},
+		},
+	},
+	"de": {
+		Second: {
+			{"0=jetzt", "one=in {0} Sekunde|other=in {0} Sekunden", "one=vor {0} Sekunde|other=vor {0} Sekunden"},
+			{"0=jetzt", "other=in {0} Sek.", "other=vor {0} Sek."},
+		},
+		Minute: {
+			{"0=in dieser Minute", "one=in {0} Minute|other=in {0} Minuten", "one=vor {0} Minute|other=vor {0} Minuten"},
+			{"0=in dieser Minute", "other=in {0} Min.", "other=vor {0} Min."},
+		},
+		Hour: {
+			{"0=in dieser Stunde", "one=in {0} Stunde|other=in {0} Stunden", "one=vor {0} Stunde|other=vor {0} Stunden"},
+			{"0=in dieser Stunde", "other=in {0} Std.", "other=vor {0} Std."},
+		},
+		Day: {
+			{"-2=vorgestern|-1=gestern|0=heute|1=morgen|2=übermorgen", "one=in {0} Tag|other=in {0} Tagen", "one=vor {0} Tag|other=vor {0} Tagen"},
+			{"-2=vorgestern|-1=gestern|0=heute|1=morgen|2=übermorgen", "one=in {0} Tag|other=in {0} Tagen", "one=vor {0} Tag|other=vor {0} Tagen"},
+		},
+		Week: {
+			{"-1=letzte Woche|0=diese Woche|1=nächste Woche", "one=in {0} Woche|other=in {0} Wochen", "one=vor {0} Woche|other=vor {0} Wochen"},
+			{"-1=letzte Woche|0=diese Woche|1=nächste Woche", "other=in {0} Wo.", "other=vor {0} Wo."},
+		},
+		Month: {
+			{"-1=letzten Monat|0=diesen Monat|1=nächsten Monat", "one=in {0} Monat|other=in {0} Monaten", "one=vor {0} Monat|other=vor {0} Monaten"},
+			{"-1=letzten Monat|0=diesen Monat|1=nächsten Monat", "one=in {0} Monat|other=in {0} Monaten", "one=vor {0} Monat|other=vor {0} Monaten"},
+		},
+		Quarter: {
+			{"-1=letztes Quartal|0=dieses Quartal|1=nächstes Quartal", "one=in {0} Quartal|other=in {0} Quartalen", "one=vor {0} Quartal|other=vor {0} Quartalen"},
+			{"-1=letztes Quartal|0=dieses Quartal|1=nächstes Quartal", "other=in {0} Quart.", "other=vor {0} Quart."},
+		},
+		Year: {
+			{"-1=letztes Jahr|0=dieses Jahr|1=nächstes Jahr", "one=in {0} Jahr|other=in {0} Jahren", "one=vor {0} Jahr|other=vor {0} Jahren"},
+			{"-1=letztes Jahr|0=dieses Jahr|1=nächstes Jahr", "one=in {0} Jahr|other=in {0} Jahren", "one=vor {0} Jahr|other=vor {0} Jahren"},
+		},
+	},
+	"fr": {
+		Second: {
+			{"0=maintenant", "one=dans {0} seconde|other=dans {0} secondes", "one=il y a {0} seconde|other=il y a {0} secondes"},
+			{"0=maintenant", "other=dans {0} s", "other=il y a {0} s"},
+		},
+		Minute: {
+			{"0=cette minute-ci", "one=dans {0} minute|other=dans {0} minutes", "one=il y a {0} minute|other=il y a {0} minutes"},
+			{"0=cette minute-ci", "other=dans {0} min", "other=il y a {0} min"},
+		},
+		Hour: {
+			{"0=cette heure-ci", "one=dans {0} heure|other=dans {0} heures", "one=il y a {0} heure|other=il y a {0} heures"},
+			{"0=cette heure-ci", "other=dans {0} h", "other=il y a {0} h"},
+		},
+		Day: {
+			{"-2=avant-hier|-1=hier|0=aujourd’hui|1=demain|2=après-demain", "one=dans {0} jour|other=dans {0} jours", "one=il y a {0} jour|other=il y a {0} jours"},
+			{"-2=avant-hier|-1=hier|0=aujourd’hui|1=demain|2=après-demain", "other=dans {0} j", "other=il y a {0} j"},
+		},
+		Week: {
+			{"-1=la semaine dernière|0=cette semaine|1=la semaine prochaine", "one=dans {0} semaine|other=dans {0} semaines", "one=il y a {0} semaine|other=il y a {0} semaines"},
+			{"-1=la semaine dernière|0=cette semaine|1=la semaine prochaine", "other=dans {0} sem.", "other=il y a {0} sem."},
+		},
+		Month: {
+			{"-1=le mois dernier|0=ce mois-ci|1=le mois prochain", "other=dans {0} mois", "other=il y a {0} mois"},
+			{"-1=le mois dernier|0=ce mois-ci|1=le mois prochain", "other=dans {0} m.", "other=il y a {0} m."},
+		},
+		Quarter: {
+			{"-1=le trimestre dernier|0=ce trimestre|1=le trimestre prochain", "one=dans {0} trimestre|other=dans {0} trimestres", "one=il y a {0} trimestre|other=il y a {0} trimestres"},
+			{"-1=le trimestre dernier|0=ce trimestre|1=le trimestre prochain", "other=dans {0} trim.", "other=il y a {0} trim."},
+		},
+		Year: {
+			{"-1=l’année dernière|0=cette année|1=l’année prochaine", "one=dans {0} an|other=dans {0} ans", "one=il y a {0} an|other=il y a {0} ans"},
+			{"-1=l’année dernière|0=cette année|1=l’année prochaine", "other=dans {0} a", "other=il y a {0} a"},
+		},
+	},
+	"es": {
+		Second: {
+			{"0=ahora", "one=dentro de {0} segundo|other=dentro de {0} segundos", "one=hace {0} segundo|other=hace {0} segundos"},
+			{"0=ahora", "other=dentro de {0} s", "other=hace {0} s"},
+		},
+		Minute: {
+			{"0=este minuto", "one=dentro de {0} minuto|other=dentro de {0} minutos", "one=hace {0} minuto|other=hace {0} minutos"},
+			{"0=este minuto", "other=dentro de {0} min", "other=hace {0} min"},
+		},
+		Hour: {
+			{"0=esta hora", "one=dentro de {0} hora|other=dentro de {0} horas", "one=hace {0} hora|other=hace {0} horas"},
+			{"0=esta hora", "other=dentro de {0} h", "other=hace {0} h"},
+		},
+		Day: {
+			{"-2=anteayer|-1=ayer|0=hoy|1=mañana|2=pasado mañana", "one=dentro de {0} día|other=dentro de {0} días", "one=hace {0} día|other=hace {0} días"},
+			{"-2=anteayer|-1=ayer|0=hoy|1=mañana|2=pasado mañana", "other=dentro de {0} d", "other=hace {0} d"},
+		},
+		Week: {
+			{"-1=la semana pasada|0=esta semana|1=la próxima semana", "one=dentro de {0} semana|other=dentro de {0} semanas", "one=hace {0} semana|other=hace {0} semanas"},
+			{"-1=sem. pasada|0=esta sem.|1=próxima sem.", "other=dentro de {0} sem.", "other=hace {0} sem."},
+		},
+		Month: {
+			{"-1=el mes pasado|0=este mes|1=el próximo mes", "one=dentro de {0} mes|other=dentro de {0} meses", "one=hace {0} mes|other=hace {0} meses"},
+			{"-1=el mes pasado|0=este mes|1=el próximo mes", "other=dentro de {0} m", "other=hace {0} m"},
+		},
+		Quarter: {
+			{"-1=el trimestre pasado|0=este trimestre|1=el próximo trimestre", "one=dentro de {0} trimestre|other=dentro de {0} trimestres", "one=hace {0} trimestre|other=hace {0} trimestres"},
+			{"-1=el trimestre pasado|0=este trimestre|1=el próximo trimestre", "other=dentro de {0} trim.", "other=hace {0} trim."},
+		},
+		Year: {
+			{"-1=el año pasado|0=este año|1=el próximo año", "one=dentro de {0} año|other=dentro de {0} años", "one=hace {0} año|other=hace {0} años"},
+			{"-1=el año pasado|0=este año|1=el próximo año", "other=dentro de {0} a", "other=hace {0} a"},
+		},
+	},
+	"it": {
+		Second: {
+			{"0=ora", "one=tra {0} secondo|other=tra {0} secondi", "one={0} secondo fa|other={0} secondi fa"},
+			{"0=ora", "other=tra {0} s", "other={0} s fa"},
+		},
+		Minute: {
+			{"0=questo minuto", "one=tra {0} minuto|other=tra {0} minuti", "one={0} minuto fa|other={0} minuti fa"},
+			{"0=questo minuto", "other=tra {0} min.", "other={0} min. fa"},
+		},
+		Hour: {
+			{"0=quest’ora", "one=tra {0} ora|other=tra {0} ore", "one={0} ora fa|other={0} ore fa"},
+			{"0=quest’ora", "other=tra {0} h", "other={0} h fa"},
+		},
+		Day: {
+			{"-2=l’altro ieri|-1=ieri|0=oggi|1=domani|2=dopodomani", "one=tra {0} giorno|other=tra {0} giorni", "one={0} giorno fa|other={0} giorni fa"},
+			{"-2=l’altro ieri|-1=ieri|0=oggi|1=domani|2=dopodomani", "other=tra {0} g", "other={0} g fa"},
+		},
+		Week: {
+			{"-1=settimana scorsa|0=questa settimana|1=settimana prossima", "one=tra {0} settimana|other=tra {0} settimane", "one={0} settimana fa|other={0} settimane fa"},
+			{"-1=settimana scorsa|0=questa settimana|1=settimana prossima", "other=tra {0} sett.", "other={0} sett. fa"},
+		},
+		Month: {
+			{"-1=mese scorso|0=questo mese|1=mese prossimo", "one=tra {0} mese|other=tra {0} mesi", "one={0} mese fa|other={0} mesi fa"},
+			{"-1=mese scorso|0=questo mese|1=mese prossimo", "one=tra {0} mese|other=tra {0} mesi", "one={0} mese fa|other={0} mesi fa"},
+		},
+		Quarter: {
+			{"-1=trimestre scorso|0=questo trimestre|1=trimestre prossimo", "one=tra {0} trimestre|other=tra {0} trimestri", "one={0} trimestre fa|other={0} trimestri fa"},
+			{"-1=trim. scorso|0=questo trim.|1=trim. prossimo", "other=tra {0} trim.", "other={0} trim. fa"},
+		},
+		Year: {
+			{"-1=anno scorso|0=quest’anno|1=anno prossimo", "one=tra {0} anno|other=tra {0} anni", "one={0} anno fa|other={0} anni fa"},
+			{"-1=anno scorso|0=quest’anno|1=anno prossimo", "one=tra {0} anno|other=tra {0} anni", "one={0} anno fa|other={0} anni fa"},
+		},
+	},
+	"nl": {
+		Second: {
+			{"0=nu", "one=over {0} seconde|other=over {0} seconden", "one={0} seconde geleden|other={0} seconden geleden"},
+			{"0=nu", "other=over {0} sec.", "other={0} sec. geleden"},
+		},
+		Minute: {
+			{"0=binnen een minuut", "one=over {0} minuut|other=over {0} minuten", "one={0} minuut geleden|other={0} minuten geleden"},
+			{"0=binnen een minuut", "other=over {0} min.", "other={0} min. geleden"},
+		},
+		Hour: {
+			{"0=binnen een uur", "other=over {0} uur", "other={0} uur geleden"},
+			{"0=binnen een uur", "other=over {0} uur", "other={0} uur geleden"},
+		},
+		Day: {
+			{"-2=eergisteren|-1=gisteren|0=vandaag|1=morgen|2=overmorgen", "one=over {0} dag|other=over {0} dagen", "one={0} dag geleden|other={0} dagen geleden"},
+			{"-2=eergisteren|-1=gisteren|0=vandaag|1=morgen|2=overmorgen", "one=over {0} dag|other=over {0} dgn", "one={0} dag geleden|other={0} dgn geleden"},
+		},
+		Week: {
+			{"-1=vorige week|0=deze week|1=volgende week", "one=over {0} week|other=over {0} weken", "one={0} week geleden|other={0} weken geleden"},
+			{"-1=vorige week|0=deze week|1=volgende week", "other=over {0} wk", "other={0} wkn geleden"},
+		},
+		Month: {
+			{"-1=vorige maand|0=deze maand|1=volgende maand", "one=over {0} maand|other=over {0} maanden", "one={0} maand geleden|other={0} maanden geleden"},
+			{"-1=vorige maand|0=deze maand|1=volgende maand", "other=over {0} mnd", "other={0} mnd geleden"},
+		},
+		Quarter: {
+			{"-1=vorig kwartaal|0=dit kwartaal|1=volgend kwartaal", "one=over {0} kwartaal|other=over {0} kwartalen", "one={0} kwartaal geleden|other={0} kwartalen geleden"},
+			{"-1=vorig kwartaal|0=dit kwartaal|1=volgend kwartaal", "other=over {0} kwart.", "other={0} kwart. geleden"},
+		},
+		Year: {
+			{"-1=vorig jaar|0=dit jaar|1=volgend jaar", "other=over {0} jaar", "other={0} jaar geleden"},
+			{"-1=vorig jaar|0=dit jaar|1=volgend jaar", "other=over {0} jaar", "other={0} jaar geleden"},
+		},
+	},
+	"ja": {
+		Second: {{"0=今", "other={0} 秒後", "other={0} 秒前"}},
+		Minute: {{"0=1 分以内", "other={0} 分後", "other={0} 分前"}},
+		Hour:   {{"0=1 時間以内", "other={0} 時間後", "other={0} 時間前"}},
+		Day:    {{"-2=一昨日|-1=昨日|0=今日|1=明日|2=明後日", "other={0} 日後", "other={0} 日前"}},
+		Week:   {{"-1=先週|0=今週|1=来週", "other={0} 週間後", "other={0} 週間前"}},
+		Month:  {{"-1=先月|0=今月|1=来月", "other={0} か月後", "other={0} か月前"}},
+		Quarter: {
+			{"-1=前四半期|0=今四半期|1=翌四半期", "other={0} 四半期後", "other={0} 四半期前"},
+		},
+		Year: {{"-1=昨年|0=今年|1=来年", "other={0} 年後", "other={0} 年前"}},
+	},
+	"zh": {
+		Second:  {{"0=现在", "other={0}秒钟后", "other={0}秒钟前"}, {"0=现在", "other={0}秒后", "other={0}秒前"}},
+		Minute:  {{"0=此刻", "other={0}分钟后", "other={0}分钟前"}},
+		Hour:    {{"0=这一时间 / 此时", "other={0}小时后", "other={0}小时前"}},
+		Day:     {{"-2=前天|-1=昨天|0=今天|1=明天|2=后天", "other={0}天后", "other={0}天前"}},
+		Week:    {{"-1=上周|0=本周|1=下周", "other={0}周后", "other={0}周前"}},
+		Month:   {{"-1=上个月|0=本月|1=下个月", "other={0}个月后", "other={0}个月前"}},
+		Quarter: {{"-1=上季度|0=本季度|1=下季度", "other={0}个季度后", "other={0}个季度前"}},
+		Year:    {{"-1=去年|0=今年|1=明年", "other={0}年后", "other={0}年前"}},
+	},
+	"ru": {
+		Second: {
+			{"0=сейчас", "one=через {0} секунду|few=через {0} секунды|many=через {0} секунд|other=через {0} секунды", "one={0} секунду назад|few={0} секунды назад|many={0} секунд назад|other={0} секунды назад"},
+			{"0=сейчас", "other=через {0} сек.", "other={0} сек. назад"},
+		},
+		Minute: {
+			{"0=в эту минуту", "one=через {0} минуту|few=через {0} минуты|many=через {0} минут|other=через {0} минуты", "one={0} минуту назад|few={0} минуты назад|many={0} минут назад|other={0} минуты назад"},
+			{"0=в эту минуту", "other=через {0} мин.", "other={0} мин. назад"},
+		},
+		Hour: {
+			{"0=в этот час", "one=через {0} час|few=через {0} часа|many=через {0} часов|other=через {0} часа", "one={0} час назад|few={0} часа назад|many={0} часов назад|other={0} часа назад"},
+			{"0=в этот час", "other=через {0} ч", "other={0} ч назад"},
+		},
+		Day: {
+			{"-2=позавчера|-1=вчера|0=сегодня|1=завтра|2=послезавтра", "one=через {0} день|few=через {0} дня|many=через {0} дней|other=через {0} дня", "one={0} день назад|few={0} дня назад|many={0} дней назад|other={0} дня назад"},
+			{"-2=позавчера|-1=вчера|0=сегодня|1=завтра|2=послезавтра", "other=через {0} дн.", "other={0} дн. назад"},
+		},
+		Week: {
+			{"-1=на прошлой неделе|0=на этой неделе|1=на следующей неделе", "one=через {0} неделю|few=через {0} недели|many=через {0} недель|other=через {0} недели", "one={0} неделю назад|few={0} недели назад|many={0} недель назад|other={0} недели назад"},
+			{"-1=на прошлой нед.|0=на этой нед.|1=на следующей нед.", "other=через {0} нед.", "other={0} нед. назад"},
+		},
+		Month: {
+			{"-1=в прошлом месяце|0=в этом месяце|1=в следующем месяце", "one=через {0} месяц|few=через {0} месяца|many=через {0} месяцев|other=через {0} месяца", "one={0} месяц назад|few={0} месяца назад|many={0} месяцев назад|other={0} месяца назад"},
+			{"-1=в прошлом мес.|0=в этом мес.|1=в следующем мес.", "other=через {0} мес.", "other={0} мес. назад"},
+		},
+		Quarter: {
+			{"-1=в прошлом квартале|0=в текущем квартале|1=в следующем квартале", "one=через {0} квартал|few=через {0} квартала|many=через {0} кварталов|other=через {0} квартала", "one={0} квартал назад|few={0} квартала назад|many={0} кварталов назад|other={0} квартала назад"},
+			{"-1=последний кв.|0=текущий кв.|1=следующий кв.", "other=через {0} кв.", "other={0} кв. назад"},
+		},
+		Year: {
+			{"-1=в прошлом году|0=в этом году|1=в следующем году", "one=через {0} год|few=через {0} года|many=через {0} лет|other=через {0} года", "one={0} год назад|few={0} года назад|many={0} лет назад|other={0} года назад"},
+			{"-1=в прошлом г.|0=в этом г.|1=в след. г.", "one=через {0} г.|few=через {0} г.|many=через {0} л.|other=через {0} г.", "one={0} г. назад|few={0} г. назад|many={0} л. назад|other={0} г. назад"},
+		},
+	},
+}
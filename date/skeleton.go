@@ -0,0 +1,340 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"sort"
+	"strings"
+)
+
+// This file implements a simplified version of the CLDR skeleton matching
+// algorithm as described in
+// https://unicode.org/reports/tr35/tr35-dates.html#Matching_Skeletons.
+
+// fieldType identifies the type of a field, grouping all pattern letters that
+// represent the same information.
+type fieldType int
+
+const (
+	typeEra fieldType = iota
+	typeYear
+	typeQuarter
+	typeMonth
+	typeWeek
+	typeDay
+	typeWeekday
+	typePeriod
+	typeHour
+	typeMinute
+	typeSecond
+	typeFraction
+	typeZone
+
+	typeUnknown
+)
+
+var letterTypes = map[byte]fieldType{
+	'G': typeEra,
+	'y': typeYear, 'Y': typeYear, 'u': typeYear, 'U': typeYear, 'r': typeYear,
+	'Q': typeQuarter, 'q': typeQuarter,
+	'M': typeMonth, 'L': typeMonth,
+	'w': typeWeek, 'W': typeWeek,
+	'd': typeDay, 'D': typeDay, 'F': typeDay, 'g': typeDay,
+	'E': typeWeekday, 'e': typeWeekday, 'c': typeWeekday,
+	'a': typePeriod, 'b': typePeriod, 'B': typePeriod,
+	'h': typeHour, 'H': typeHour, 'K': typeHour, 'k': typeHour,
+	'm': typeMinute,
+	's': typeSecond,
+	'S': typeFraction, 'A': typeFraction,
+	'z': typeZone, 'Z': typeZone, 'O': typeZone, 'v': typeZone, 'V': typeZone, 'X': typeZone, 'x': typeZone,
+}
+
+func typeOf(letter byte) fieldType {
+	if t, ok := letterTypes[letter]; ok {
+		return t
+	}
+	return typeUnknown
+}
+
+// isText reports whether a field represents a name, rather than a number.
+func isText(letter byte, count int) bool {
+	switch typeOf(letter) {
+	case typeEra, typePeriod, typeZone:
+		return true
+	case typeQuarter, typeMonth:
+		return count >= 3
+	case typeWeekday:
+		return letter == 'E' || count >= 3
+	}
+	return false
+}
+
+// is12Hour reports whether an hour letter represents a 12-hour clock.
+func is12Hour(letter byte) bool {
+	return letter == 'h' || letter == 'K'
+}
+
+// A skeleton is a parsed skeleton with at most one field per field type.
+type skeleton [typeUnknown]field
+
+func parseSkeleton(s string) (sk skeleton) {
+	for _, f := range parsePattern(s) {
+		if f.letter == 0 {
+			continue
+		}
+		if t := typeOf(f.letter); t != typeUnknown {
+			sk[t] = f
+		}
+	}
+	return sk
+}
+
+// String returns the canonical string representation of the skeleton.
+func (sk *skeleton) String() string {
+	var b strings.Builder
+	for _, f := range sk {
+		for i := 0; i < f.count; i++ {
+			b.WriteByte(f.letter)
+		}
+	}
+	return b.String()
+}
+
+func (sk *skeleton) isEmpty() bool {
+	for _, f := range sk {
+		if f.count > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// split splits a skeleton into its date and time components.
+func (sk *skeleton) split() (date, time skeleton) {
+	for i, f := range sk {
+		if fieldType(i) < typePeriod {
+			date[i] = f
+		} else {
+			time[i] = f
+		}
+	}
+	return date, time
+}
+
+const (
+	// A mismatch of field types disqualifies a pattern.
+	noMatch = 1 << 20
+	// A mismatch between a numeric and text representation or between a
+	// 12-hour and 24-hour clock is considered significant.
+	kindMismatch = 1 << 8
+)
+
+// distance computes how well the fields of pattern skeleton p match the
+// requested skeleton. It returns noMatch if the types of fields do not match
+// and, if partial is true, only requires the fields of p to be a subset of
+// the requested fields.
+func (sk *skeleton) distance(p *skeleton, partial bool) int {
+	d := 0
+	for i := range sk {
+		want, have := sk[i], p[i]
+		switch {
+		case want.count == 0 && have.count == 0:
+		case fieldType(i) == typePeriod:
+			// The day period is implied by the hour field.
+		case have.count == 0:
+			if !partial {
+				return noMatch
+			}
+			d += kindMismatch
+		case want.count == 0:
+			return noMatch
+		default:
+			if isText(want.letter, want.count) != isText(have.letter, have.count) {
+				d += kindMismatch
+			}
+			if fieldType(i) == typeHour && is12Hour(want.letter) != is12Hour(have.letter) {
+				d += kindMismatch
+			}
+			if want.letter != have.letter {
+				d++
+			}
+			if n := want.count - have.count; n < 0 {
+				d -= n
+			} else {
+				d += n
+			}
+		}
+	}
+	return d
+}
+
+// bestPattern returns the pattern from the available formats that best
+// matches the given skeleton.
+func (l *locale) bestPattern(sk *skeleton) string {
+	if p, ok := l.matchSkeleton(sk, false); ok {
+		return p
+	}
+	date, time := sk.split()
+	var datePat, timePat string
+	if !date.isEmpty() {
+		datePat = l.bestPartialPattern(&date)
+	}
+	if !time.isEmpty() {
+		timePat = l.bestPartialPattern(&time)
+	}
+	switch {
+	case datePat == "":
+		return timePat
+	case timePat == "":
+		return datePat
+	}
+	// Select the date time glue pattern based on the representation of the
+	// month and weekday.
+	length := Short
+	if m := date[typeMonth]; m.count >= 4 {
+		length = Long
+		if date[typeWeekday].count > 0 {
+			length = Full
+		}
+	} else if m.count == 3 {
+		length = Medium
+	}
+	return combine(l.dateTimeFormats[length], datePat, timePat)
+}
+
+// bestPartialPattern returns the best matching pattern for sk, appending any
+// fields that are not covered by the available formats.
+func (l *locale) bestPartialPattern(sk *skeleton) string {
+	if p, ok := l.matchSkeleton(sk, false); ok {
+		return p
+	}
+	p, ok := l.matchSkeleton(sk, true)
+	covered := parseSkeleton(p)
+	if !ok {
+		covered = skeleton{}
+	}
+	var b strings.Builder
+	b.WriteString(p)
+	for i, f := range sk {
+		if f.count == 0 || covered[i].count > 0 || fieldType(i) == typePeriod {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		for j := 0; j < f.count; j++ {
+			b.WriteByte(f.letter)
+		}
+	}
+	return b.String()
+}
+
+// matchSkeleton finds the available format with the smallest distance to sk
+// and adjusts its field lengths to those of sk.
+func (l *locale) matchSkeleton(sk *skeleton, partial bool) (pattern string, ok bool) {
+	keys := make([]string, 0, len(l.availableFormats))
+	for k := range l.availableFormats {
+		keys = append(keys, k)
+	}
+	// Sort the keys to make the result deterministic.
+	sort.Strings(keys)
+	best, bestKey := noMatch, ""
+	for _, k := range keys {
+		p := parseSkeleton(k)
+		if d := sk.distance(&p, partial); d < best {
+			best, bestKey = d, k
+		}
+	}
+	if bestKey == "" {
+		return "", false
+	}
+	return adjustFields(l.availableFormats[bestKey], sk), true
+}
+
+// adjustFields adjusts the length of fields in pattern to match the requested
+// lengths of sk.
+func adjustFields(pattern string, sk *skeleton) string {
+	fields := parsePattern(pattern)
+	for i, f := range fields {
+		if f.letter == 0 {
+			continue
+		}
+		t := typeOf(f.letter)
+		if t == typeUnknown {
+			continue
+		}
+		want := sk[t]
+		switch {
+		case want.count == 0:
+		case t == typeHour:
+			// Hours may be changed to match the requested hour cycle, but
+			// their length is kept.
+			fields[i].letter = want.letter
+		case t == typeMinute, t == typeSecond:
+		case isText(f.letter, f.count) == isText(want.letter, want.count):
+			fields[i].count = want.count
+		}
+	}
+	return formatFields(fields)
+}
+
+// formatFields converts fields back to a pattern string.
+func formatFields(fields []field) string {
+	var b strings.Builder
+	for _, f := range fields {
+		if f.letter == 0 {
+			lit := strings.Replace(f.literal, "'", "''", -1)
+			if strings.IndexFunc(lit, isLetter) >= 0 {
+				lit = "'" + lit + "'"
+			}
+			b.WriteString(lit)
+			continue
+		}
+		for j := 0; j < f.count; j++ {
+			b.WriteByte(f.letter)
+		}
+	}
+	return b.String()
+}
+
+func isLetter(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z'
+}
+
+// combine creates a pattern from a date time glue pattern and a date and time
+// pattern.
+func combine(glue, date, time string) string {
+	r := strings.NewReplacer("{1}", date, "{0}", time)
+	return r.Replace(glue)
+}
+
+// setHourCycle changes the hour fields of pattern to the given hour letter. If
+// this changes between a 12 and 24 hour clock, the pattern is rederived from
+// the available formats so that the day periods are added or removed.
+func (l *locale) setHourCycle(pattern string, hour byte) string {
+	fields := parsePattern(pattern)
+	sk := skeleton{}
+	found := false
+	for i, f := range fields {
+		if f.letter == 0 {
+			continue
+		}
+		t := typeOf(f.letter)
+		if t == typeHour {
+			if is12Hour(f.letter) != is12Hour(hour) {
+				found = true
+			}
+			fields[i].letter = hour
+			f.letter = hour
+		}
+		if t != typeUnknown && t != typePeriod {
+			sk[t] = f
+		}
+	}
+	if !found {
+		return formatFields(fields)
+	}
+	return l.bestPattern(&sk)
+}
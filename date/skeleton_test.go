@@ -0,0 +1,66 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestBestPattern(t *testing.T) {
+	testCases := []struct {
+		tag      string
+		skeleton string
+		want     string
+	}{
+		{"en", "yMMMd", "MMM d, y"},
+		{"en", "dMMMy", "MMM d, y"},
+		{"en", "yMMMMd", "MMMM d, y"},
+		{"en", "MMMMd", "MMMM d"},
+		{"en", "MMMM", "LLLL"},
+		{"en", "yyMd", "M/d/yy"},
+		{"en", "hm", "h:mm a"},
+		{"en", "Hm", "HH:mm"},
+		{"en", "hmv", "h:mm a v"},
+		{"en", "Kms", "K:mm:ss a"},
+		{"en", "yMMMdhm", "MMM d, y, h:mm a"},
+		{"en", "yMMMMEEEEdHm", "EEEE, MMMM d, y 'at' HH:mm"},
+		{"en", "yQQQQ", "QQQQ y"},
+		{"de", "yMMMd", "d. MMM y"},
+		{"de", "hm", "h:mm a"},
+		{"ja", "yMMMEd", "y年M月d日(E)"},
+	}
+	for _, tc := range testCases {
+		l := lookupLocale(language.MustParse(tc.tag))
+		sk := parseSkeleton(tc.skeleton)
+		if got := l.bestPattern(&sk); got != tc.want {
+			t.Errorf("%s:%s: got %q; want %q", tc.tag, tc.skeleton, got, tc.want)
+		}
+	}
+}
+
+func TestParsePattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		want    []field
+	}{
+		{"", nil},
+		{"yyyy", []field{{letter: 'y', count: 4}}},
+		{"d MMM", []field{{letter: 'd', count: 1}, {literal: " "}, {letter: 'M', count: 3}}},
+		{"h 'o''clock'", []field{{letter: 'h', count: 1}, {literal: " o'clock"}}},
+		{"''H", []field{{literal: "'"}, {letter: 'H', count: 1}}},
+	}
+	for _, tc := range testCases {
+		got := parsePattern(tc.pattern)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: got %v; want %v", tc.pattern, got, tc.want)
+		}
+		if s := formatFields(got); !reflect.DeepEqual(parsePattern(s), got) {
+			t.Errorf("%q: formatFields: got %q", tc.pattern, s)
+		}
+	}
+}
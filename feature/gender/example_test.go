@@ -0,0 +1,41 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gender_test
+
+import (
+	"golang.org/x/text/feature/gender"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func ExampleSelect() {
+	// argument 1: name, argument 2: gender, argument 3: number of photos
+	message.Set(language.English, "%[1]s liked your %[3]d photo(s).",
+		gender.Select(2,
+			gender.Female, plural.Selectf(3, "%d",
+				plural.One, "%[1]s liked your photo. She thinks it's great!",
+				plural.Other, "%[1]s liked your %[3]d photos. She thinks they're great!"),
+			gender.Male, plural.Selectf(3, "%d",
+				plural.One, "%[1]s liked your photo. He thinks it's great!",
+				plural.Other, "%[1]s liked your %[3]d photos. He thinks they're great!"),
+			gender.Other, plural.Selectf(3, "%d",
+				plural.One, "%[1]s liked your photo. They think it's great!",
+				plural.Other, "%[1]s liked your %[3]d photos. They think they're great!"),
+		))
+
+	p := message.NewPrinter(language.English)
+	p.Printf("%[1]s liked your %[3]d photo(s).", "Ann", gender.Female, 1)
+	p.Println()
+	p.Printf("%[1]s liked your %[3]d photo(s).", "Bob", gender.Male, 3)
+	p.Println()
+	p.Printf("%[1]s liked your %[3]d photo(s).", "Sam", "unknown", 2)
+	p.Println()
+
+	// Output:
+	// Ann liked your photo. She thinks it's great!
+	// Bob liked your 3 photos. He thinks they're great!
+	// Sam liked your 2 photos. They think they're great!
+}
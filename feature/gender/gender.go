@@ -0,0 +1,120 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gender provides messages that select a variant based on the
+// grammatical gender, or any other enumerated string value, of an argument.
+//
+// This package is UNDER CONSTRUCTION and its API may change.
+package gender
+
+import (
+	"fmt"
+
+	// Register the plural messages first so that their handles, which are
+	// stored in generated catalogs, do not depend on the use of this package.
+	_ "golang.org/x/text/feature/plural"
+
+	"golang.org/x/text/internal/catmsg"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// Common values for grammatical gender. Any string can be used as a selector,
+// though, as the set of genders and their names depend on the language.
+const (
+	Female = "female"
+	Male   = "male"
+	Neuter = "neuter"
+
+	// Other matches any value.
+	Other = "other"
+)
+
+// Interface is used for types that can determine their own grammatical gender.
+type Interface interface {
+	// Gender reports the grammatical gender of the underlying value for the
+	// given language, such as "female" or "male".
+	Gender(t language.Tag) string
+}
+
+// Select returns the first case for which its selector is a match for the
+// arg-th substitution argument to a formatting call.
+//
+// The cases argument are pairs of selectors and messages. Selectors are of type
+// string. Messages are of type string or catalog.Message. A selector matches an
+// argument if:
+//   - it is "other"
+//   - it equals the value of the argument, which is the result of its Gender
+//     method if it implements Interface, the argument itself if it is a
+//     string, and its formatted value, as by fmt.Sprint, otherwise.
+//
+// Select can be nested with plural.Selectf and other messages to select a
+// variant based on several arguments.
+func Select(arg int, cases ...interface{}) catalog.Message {
+	return &message{arg, cases}
+}
+
+type message struct {
+	arg   int
+	cases []interface{}
+}
+
+var handle = catmsg.Register("golang.org/x/text/feature/gender:select", execute)
+
+func (m *message) Compile(e *catmsg.Encoder) error {
+	e.EncodeMessageType(handle)
+
+	e.EncodeUint(uint64(m.arg))
+
+	for i := 0; i < len(m.cases); {
+		selector, ok := m.cases[i].(string)
+		if !ok {
+			return fmt.Errorf("gender: selector of type %T; want string", m.cases[i])
+		}
+		if selector == "" {
+			return fmt.Errorf("gender: empty selector")
+		}
+		e.EncodeString(selector)
+		if i++; i >= len(m.cases) {
+			return fmt.Errorf("gender: no message defined for selector %v", selector)
+		}
+		var msg catalog.Message
+		switch x := m.cases[i].(type) {
+		case string:
+			msg = catalog.String(x)
+		case catalog.Message:
+			msg = x
+		default:
+			return fmt.Errorf("gender: message of type %T; must be string or catalog.Message", x)
+		}
+		if err := e.EncodeMessage(msg); err != nil {
+			return err
+		}
+		i++
+	}
+	return nil
+}
+
+func execute(d *catmsg.Decoder) bool {
+	argN := int(d.DecodeUint())
+	value, hasValue := "", false
+	switch x := d.Arg(argN).(type) {
+	case nil:
+		// Only matches Other.
+	case Interface:
+		value, hasValue = x.Gender(d.Language()), true
+	case string:
+		value, hasValue = x, true
+	default:
+		value, hasValue = fmt.Sprint(x), true
+	}
+	for !d.Done() {
+		s := d.DecodeString()
+		if s == Other || (hasValue && s == value) {
+			return d.ExecuteMessage()
+		}
+		d.SkipMessage()
+	}
+	return false
+}
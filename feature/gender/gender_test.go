@@ -0,0 +1,148 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gender
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/catmsg"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestSelect(t *testing.T) {
+	type test struct {
+		args   []interface{}
+		result string
+		err    string
+	}
+	testCases := []struct {
+		desc  string
+		msg   catalog.Message
+		err   string
+		tests []test
+	}{{
+		desc: "basic",
+		msg:  Select(1, Female, "she", Male, "he", Other, "they"),
+		tests: []test{
+			{args: args("female"), result: "she"},
+			{args: args("male"), result: "he"},
+			{args: args("neuter"), result: "they"},
+			{args: args(person{"Ann", Female}), result: "she"},
+			{args: args(person{"Bob", Male}), result: "he"},
+			{args: args(nil), result: "they"},
+		},
+	}, {
+		desc: "enumerated values",
+		msg:  Select(1, "true", "yes", "3", "three", "gold", "gilded", Other, "-"),
+		tests: []test{
+			{args: args(true), result: "yes"},
+			{args: args(3), result: "three"},
+			{args: args(level(2)), result: "gilded"},
+			{args: args(false), result: "-"},
+		},
+	}, {
+		desc: "first match",
+		msg:  Select(1, Other, "other", Female, "cannot match"),
+		tests: []test{
+			{args: args(Female), result: "other"},
+		},
+	}, {
+		desc: "nested",
+		msg: Select(1,
+			Female, plural.Selectf(2, "", plural.One, "her file", plural.Other, "her files"),
+			Other, Select(2, "1", "their file", Other, "their files")),
+		tests: []test{
+			{args: args(Female, 1), result: "her file"},
+			{args: args(Female, 2), result: "her files"},
+			{args: args(Male, 1), result: "their file"},
+			{args: args(Male, 3), result: "their files"},
+		},
+	}, {
+		desc:  "nested in plural",
+		msg:   plural.Selectf(2, "", plural.One, Select(1, Male, "his file"), plural.Other, "files"),
+		tests: []test{{args: args(Male, 1), result: "his file"}},
+	}, {
+		desc:  "no match",
+		msg:   Select(1, Female, "she"),
+		tests: []test{{args: args(Male), err: catmsg.ErrNoMatch.Error()}},
+	}, {
+		desc: "error invalid selector",
+		err:  `selector of type int; want string`,
+		msg:  Select(1, 1, "foo"),
+	}, {
+		desc: "error empty selector",
+		err:  `empty selector`,
+		msg:  Select(1, "", "foo"),
+	}, {
+		desc: "error missing message",
+		err:  `no message defined for selector female`,
+		msg:  Select(1, Female),
+	}, {
+		desc: "error invalid message",
+		err:  `message of type int; must be string or catalog.Message`,
+		msg:  Select(1, Female, 3),
+	}, {
+		desc: "nested error",
+		err:  `empty selector`,
+		msg:  Select(1, Other, Select(2, "")),
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			data, err := catmsg.Compile(language.English, nil, tc.msg)
+			chkError(t, err, tc.err)
+			for _, tx := range tc.tests {
+				t.Run(fmt.Sprint(tx.args...), func(t *testing.T) {
+					r := renderer{args: tx.args}
+					d := catmsg.NewDecoder(language.English, &r, nil)
+					err := d.Execute(data)
+					chkError(t, err, tx.err)
+					if r.result != tx.result {
+						t.Errorf("got %q; want %q", r.result, tx.result)
+					}
+				})
+			}
+		})
+	}
+}
+
+func chkError(t *testing.T, got error, want string) {
+	if (got == nil && want != "") ||
+		(got != nil && (want == "" || !strings.Contains(got.Error(), want))) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+	if got != nil {
+		t.SkipNow()
+	}
+}
+
+func args(a ...interface{}) []interface{} { return a }
+
+type renderer struct {
+	args   []interface{}
+	result string
+}
+
+func (r *renderer) Render(s string) { r.result += s }
+func (r *renderer) Arg(i int) interface{} {
+	if i < 1 || i > len(r.args) {
+		return nil
+	}
+	return r.args[i-1]
+}
+
+type person struct {
+	name   string
+	gender string
+}
+
+func (p person) Gender(t language.Tag) string { return p.gender }
+
+type level int
+
+func (l level) String() string { return [...]string{"bronze", "silver", "gold"}[l] }
@@ -5,6 +5,8 @@
 package plural_test
 
 import (
+	"fmt"
+
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -44,3 +46,56 @@ func ExampleSelectf() {
 	// nog één bestand te gaan
 	// klaar!
 }
+
+func ExampleSelectOrdinalf() {
+	message.Set(language.English, "You finished %d.",
+		plural.SelectOrdinalf(1, "%d",
+			plural.One, "You finished %[1]dst.",
+			plural.Two, "You finished %[1]dnd.",
+			plural.Few, "You finished %[1]drd.",
+			plural.Other, "You finished %[1]dth.",
+		))
+
+	p := message.NewPrinter(language.English)
+	for _, n := range []int{1, 2, 3, 4, 11, 22} {
+		p.Printf("You finished %d.", n)
+		p.Println()
+	}
+
+	// Output:
+	// You finished 1st.
+	// You finished 2nd.
+	// You finished 3rd.
+	// You finished 4th.
+	// You finished 11th.
+	// You finished 22nd.
+}
+
+func ExampleRules_Register() {
+	// Define the rules for a language that is not covered by CLDR.
+	tag := language.MustParse("x-lang")
+	err := plural.Cardinal.Register(tag, map[plural.Form]string{
+		plural.One: "n % 10 = 1 and n % 100 != 11",
+	})
+	if err != nil {
+		panic(err)
+	}
+	message.Set(tag, "%d apples",
+		plural.Selectf(1, "%d",
+			plural.One, "%[1]d apple (one)",
+			plural.Other, "%[1]d apples (other)",
+		))
+
+	p := message.NewPrinter(tag)
+	for _, n := range []int{1, 11, 21} {
+		p.Printf("%d apples", n)
+		p.Println()
+	}
+	fmt.Println(len(plural.Cardinal.Forms(tag)))
+
+	// Output:
+	// 1 apple (one)
+	// 11 apples (other)
+	// 21 apple (one)
+	// 2
+}
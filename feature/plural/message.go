@@ -7,6 +7,7 @@ package plural
 import (
 	"fmt"
 	"io"
+	"math/big"
 	"reflect"
 	"strconv"
 
@@ -54,10 +55,22 @@ type Interface interface {
 //   - %.2e   scientific notation with precision 3 (scale + 1)
 //   - %d     integer
 func Selectf(arg int, format string, cases ...interface{}) catalog.Message {
+	return newMessage(cardinal, arg, format, cases)
+}
+
+// SelectOrdinalf is like Selectf, but selects the case using the ordinal
+// plural rules of the language, which determine the form of the argument as
+// a position, as in "1st", "2nd", "3rd", and "4th". For English, the forms
+// One, Two, Few, and Other correspond to these examples.
+func SelectOrdinalf(arg int, format string, cases ...interface{}) catalog.Message {
+	return newMessage(ordinal, arg, format, cases)
+}
+
+func newMessage(rules *Rules, arg int, format string, cases []interface{}) *message {
 	var p parser
 	// Intercept the formatting parameters of format by doing a dummy print.
 	fmt.Fprintf(io.Discard, format, &p)
-	m := &message{arg, kindDefault, 0, cases}
+	m := &message{rules, arg, kindDefault, 0, cases}
 	switch p.verb {
 	case 'g':
 		m.kind = kindPrecision
@@ -91,6 +104,7 @@ func (p *parser) Format(s fmt.State, verb rune) {
 }
 
 type message struct {
+	rules *Rules
 	arg   int
 	kind  int
 	scale int
@@ -105,10 +119,17 @@ const (
 	kindPrecision  // verb g, number of significant digits follows
 )
 
-var handle = catmsg.Register("golang.org/x/text/feature/plural:plural", execute)
+var (
+	handle        = catmsg.Register("golang.org/x/text/feature/plural:plural", executeCardinal)
+	ordinalHandle = catmsg.Register("golang.org/x/text/feature/plural:ordinal", executeOrdinal)
+)
 
 func (m *message) Compile(e *catmsg.Encoder) error {
-	e.EncodeMessageType(handle)
+	if m.rules == ordinal {
+		e.EncodeMessageType(ordinalHandle)
+	} else {
+		e.EncodeMessageType(handle)
+	}
 
 	e.EncodeUint(uint64(m.arg))
 
@@ -117,7 +138,7 @@ func (m *message) Compile(e *catmsg.Encoder) error {
 		e.EncodeUint(uint64(m.scale))
 	}
 
-	forms := validForms(cardinal, e.Language())
+	forms := validForms(m.rules, e.Language())
 
 	for i := 0; i < len(m.cases); {
 		if err := compileSelector(e, forms, m.cases[i]); err != nil {
@@ -184,7 +205,10 @@ func compileSelector(e *catmsg.Encoder, valid []Form, selector interface{}) erro
 	return nil
 }
 
-func execute(d *catmsg.Decoder) bool {
+func executeCardinal(d *catmsg.Decoder) bool { return execute(d, cardinal) }
+func executeOrdinal(d *catmsg.Decoder) bool  { return execute(d, ordinal) }
+
+func execute(d *catmsg.Decoder, rules *Rules) bool {
 	lang := d.Language()
 	argN := int(d.DecodeUint())
 	kind := int(d.DecodeUint())
@@ -198,10 +222,15 @@ func execute(d *catmsg.Decoder) bool {
 		// Default to Other.
 	} else if x, ok := arg.(number.VisibleDigits); ok {
 		d := x.Digits(nil, lang, scale)
-		form, n = cardinal.matchDisplayDigits(lang, &d)
+		form, n = rules.matchDisplayDigits(lang, &d)
 	} else if x, ok := arg.(Interface); ok {
 		// This covers lists and formatters from the number package.
 		form, n = x.PluralForm(lang, scale)
+		if rules != cardinal {
+			// PluralForm reports the cardinal form, so derive the form from
+			// the integer value instead.
+			form = rules.matchComponents(lang, n, 0, 0)
+		}
 	} else {
 		var f number.Formatter
 		switch kind {
@@ -219,6 +248,8 @@ func execute(d *catmsg.Decoder) bool {
 			f.InitDecimal(lang)
 			if k := reflect.TypeOf(arg).Kind(); reflect.Int <= k && k <= reflect.Uintptr {
 				f.SetScale(0)
+			} else if _, ok := arg.(*big.Int); ok {
+				f.SetScale(0)
 			} else {
 				f.SetScale(2)
 			}
@@ -227,7 +258,7 @@ func execute(d *catmsg.Decoder) bool {
 		dec.Convert(f.RoundingContext, arg)
 		v := number.FormatDigits(&dec, f.RoundingContext)
 		if !v.NaN && !v.Inf {
-			form, n = cardinal.matchDisplayDigits(d.Language(), &v)
+			form, n = rules.matchDisplayDigits(d.Language(), &v)
 		}
 	}
 	for !d.Done() {
@@ -6,6 +6,7 @@ package plural
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -96,6 +97,27 @@ func TestSelect(t *testing.T) {
 			{arg: 2, result: "bar"},
 			{arg: 1.0, result: "bar"},
 		},
+	}, {
+		desc: "arbitrary precision",
+		msg:  Selectf(1, "", "one", "foo", "other", "bar"),
+		tests: []test{
+			{arg: big.NewInt(1), result: "foo"},
+			{arg: big.NewInt(2), result: "bar"},
+			{arg: mkbigint("100000000000000000001"), result: "bar"},
+			{arg: big.NewRat(1, 1), result: "bar"},
+			{arg: big.NewFloat(1), result: "bar"},
+			{arg: "1", result: "bar"},
+		},
+	}, {
+		desc: "arbitrary precision without fractions",
+		msg:  Selectf(1, "%.0f", "one", "foo", "other", "bar"),
+		tests: []test{
+			{arg: big.NewRat(5, 4), result: "foo"},
+			{arg: big.NewRat(3, 2), result: "bar"},
+			{arg: "1.4999999999999999999999", result: "foo"},
+			{arg: "1.5000000000000000000001", result: "bar"},
+			{arg: big.NewFloat(0.9), result: "foo"},
+		},
 	}, {
 		desc: "nested",
 		msg:  Selectf(1, "", "other", Selectf(2, "", "one", "foo", "other", "bar")),
@@ -104,6 +126,31 @@ func TestSelect(t *testing.T) {
 			{arg: 1, result: "foo"},
 			{arg: 2, result: "bar"},
 		},
+	}, {
+		desc: "ordinal",
+		msg: SelectOrdinalf(1, "%d",
+			"one", "st", "two", "nd", "few", "rd", "other", "th"),
+		tests: []test{
+			{arg: 1, result: "st"},
+			{arg: 2, result: "nd"},
+			{arg: 3, result: "rd"},
+			{arg: 4, result: "th"},
+			{arg: 11, result: "th"},
+			{arg: 12, result: "th"},
+			{arg: 21, result: "st"},
+			{arg: 103, result: "rd"},
+			{arg: big.NewInt(22), result: "nd"},
+			{arg: opposite(23), result: "rd"},
+			{arg: "unknown", result: "th"},
+		},
+	}, {
+		desc: "ordinal comparisons",
+		msg:  SelectOrdinalf(1, "", "=1", "first", "one", "st", Other, "th"),
+		tests: []test{
+			{arg: 1, result: "first"},
+			{arg: 31, result: "st"},
+			{arg: 5, result: "th"},
+		},
 	}, {
 		desc:  "arg unavailable",
 		msg:   Selectf(100, "%.2f", "one", "foo", "other", "bar"),
@@ -120,6 +167,14 @@ func TestSelect(t *testing.T) {
 		desc: "error form not used by language",
 		err:  `form "many" not supported for language "en"`,
 		msg:  Selectf(1, "%d", "many", "foo"),
+	}, {
+		desc: "error ordinal form not used by language",
+		err:  `form "zero" not supported for language "en"`,
+		msg:  SelectOrdinalf(1, "%d", "zero", "foo"),
+	}, {
+		desc: "error cardinal form not used for ordinals",
+		err:  `form "many" not supported for language "en"`,
+		msg:  SelectOrdinalf(1, "%d", "many", "foo"),
 	}, {
 		desc: "error invalid selector",
 		err:  `selector of type int; want string or Form`,
@@ -195,3 +250,8 @@ func (o opposite) PluralForm(lang language.Tag, scale int) (Form, int) {
 	}
 	return One, int(o)
 }
+
+func mkbigint(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 10)
+	return x
+}
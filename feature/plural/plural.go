@@ -26,6 +26,8 @@ type Rules struct {
 	index          []byte
 	langToIndex    []byte
 	inclusionMasks []uint64
+
+	customRules
 }
 
 var (
@@ -37,17 +39,17 @@ var (
 	Ordinal *Rules = ordinal
 
 	ordinal = &Rules{
-		ordinalRules,
-		ordinalIndex,
-		ordinalLangToIndex,
-		ordinalInclusionMasks[:],
+		rules:          ordinalRules,
+		index:          ordinalIndex,
+		langToIndex:    ordinalLangToIndex,
+		inclusionMasks: ordinalInclusionMasks[:],
 	}
 
 	cardinal = &Rules{
-		cardinalRules,
-		cardinalIndex,
-		cardinalLangToIndex,
-		cardinalInclusionMasks[:],
+		rules:          cardinalRules,
+		index:          cardinalIndex,
+		langToIndex:    cardinalLangToIndex,
+		inclusionMasks: cardinalInclusionMasks[:],
 	}
 )
 
@@ -114,6 +116,9 @@ func getIntApprox(digits []byte, start, end, nMod, big int) (n int) {
 //	100000     []byte{1}           6      0
 //	100000.00  []byte{1}           6      3
 func (p *Rules) MatchDigits(t language.Tag, digits []byte, exp, scale int) Form {
+	if rs := p.lookup(t); rs != nil {
+		return rs.match(digitOperands(digits, exp, scale))
+	}
 	index := tagToID(t)
 
 	// Differentiate up to including mod 1000000 for the integer part.
@@ -131,6 +136,9 @@ func (p *Rules) matchDisplayDigits(t language.Tag, d *number.Digits) (Form, int)
 }
 
 func validForms(p *Rules, t language.Tag) (forms []Form) {
+	if rs := p.lookup(t); rs != nil {
+		return rs.forms()
+	}
 	offset := p.langToIndex[tagToID(t)]
 	rules := p.rules[p.index[offset]:p.index[offset+1]]
 
@@ -146,6 +154,9 @@ func validForms(p *Rules, t language.Tag) (forms []Form) {
 }
 
 func (p *Rules) matchComponents(t language.Tag, n, f, scale int) Form {
+	if rs := p.lookup(t); rs != nil {
+		return rs.match(componentOperands(n, f, scale))
+	}
 	return matchPlural(p, tagToID(t), n, f, scale)
 }
 
@@ -165,6 +176,9 @@ func (p *Rules) matchComponents(t language.Tag, n, f, scale int) Form {
 // If any of the operand values is too large to fit in an int, it is okay to
 // pass the value modulo 10,000,000.
 func (p *Rules) MatchPlural(lang language.Tag, i, v, w, f, t int) Form {
+	if rs := p.lookup(lang); rs != nil {
+		return rs.match(&operands{i: i, v: v, w: w, f: f, t: t})
+	}
 	return matchPlural(p, tagToID(lang), i, f, v)
 }
 
@@ -0,0 +1,375 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plural
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/text/language"
+)
+
+// Register sets the rules of p for language t, and for languages that have t
+// as a parent, overriding the rules defined by CLDR. It can be used for
+// languages and private-use tags for which CLDR does not define rules.
+//
+// The rules map each form but Other to a condition in the syntax of the CLDR
+// plural rules, such as "n % 10 = 1 and n % 100 != 11". Samples, which start
+// with '@', are ignored. The conditions are evaluated in the order Zero, One,
+// Two, Few, and Many; a number that matches none of them has the form Other.
+// See https://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules
+// for details.
+func (p *Rules) Register(t language.Tag, rules map[Form]string) error {
+	rs := &ruleSet{}
+	for f, s := range rules {
+		if f == Other || f > Many {
+			return fmt.Errorf("plural: cannot define rule for form %v", f)
+		}
+		c, err := parseCondition(s)
+		if err != nil {
+			return err
+		}
+		rs.conds = append(rs.conds, formCondition{f, c})
+	}
+	sort.Slice(rs.conds, func(i, j int) bool {
+		return rs.conds[i].form < rs.conds[j].form
+	})
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	m := map[language.Tag]*ruleSet{t: rs}
+	if old := p.custom.Load(); old != nil {
+		for k, v := range *old {
+			if k != t {
+				m[k] = v
+			}
+		}
+	}
+	p.custom.Store(&m)
+	return nil
+}
+
+// Forms returns the plural forms used by language t in increasing order. The
+// first form is always Other.
+func (p *Rules) Forms(t language.Tag) []Form {
+	forms := validForms(p, t)
+	sort.Slice(forms, func(i, j int) bool { return forms[i] < forms[j] })
+	return forms
+}
+
+// customRules holds the rules registered with Register.
+type customRules struct {
+	mu     sync.Mutex // serializes writers
+	custom atomic.Pointer[map[language.Tag]*ruleSet]
+}
+
+// lookup returns the rules registered for t or its parents or nil if there
+// are none.
+func (c *customRules) lookup(t language.Tag) *ruleSet {
+	m := c.custom.Load()
+	if m == nil {
+		return nil
+	}
+	for {
+		if rs, ok := (*m)[t]; ok {
+			return rs
+		}
+		if t.IsRoot() {
+			return nil
+		}
+		t = t.Parent()
+	}
+}
+
+// A ruleSet holds the parsed rules of a language.
+type ruleSet struct {
+	conds []formCondition // ordered by form
+}
+
+type formCondition struct {
+	form Form
+	cond condition
+}
+
+func (rs *ruleSet) forms() []Form {
+	forms := []Form{Other}
+	for _, c := range rs.conds {
+		forms = append(forms, c.form)
+	}
+	return forms
+}
+
+func (rs *ruleSet) match(o *operands) Form {
+	for _, c := range rs.conds {
+		if c.cond.match(o) {
+			return c.form
+		}
+	}
+	return Other
+}
+
+// operands holds the plural operands of a number. Integer values that are
+// too large are taken modulo 10^18 and offset by 10^18, which preserves the
+// result of the modulo operations used in the rules.
+type operands struct {
+	i, v, w, f, t int
+}
+
+const maxOperand = 1000000000000000000 // 10^18
+
+// digitOperands returns the operands for the decimal represented by digits,
+// as described for MatchDigits.
+func digitOperands(digits []byte, exp, scale int) *operands {
+	o := &operands{v: scale}
+	start := exp - 18
+	if start < 0 {
+		start = 0
+	}
+	o.i = getIntApprox(digits, start, exp, exp-start, 0)
+	for p := 0; p < start && p < len(digits); p++ {
+		if digits[p] != 0 {
+			o.i += maxOperand
+			break
+		}
+	}
+	if scale > 18 {
+		scale = 18
+	}
+	o.f = getIntApprox(digits, exp, exp+scale, scale, 0)
+	o.t, o.w = o.f, scale
+	for o.w > 0 && o.t%10 == 0 {
+		o.t /= 10
+		o.w--
+	}
+	return o
+}
+
+// componentOperands returns the operands for a number with integer part n and
+// scale visible fraction digits f.
+func componentOperands(n, f, scale int) *operands {
+	o := &operands{i: n, v: scale, f: f, t: f, w: scale}
+	for o.w > 0 && o.t%10 == 0 {
+		o.t /= 10
+		o.w--
+	}
+	return o
+}
+
+// condition is a disjunction of conjunctions of relations.
+type condition [][]relation
+
+func (c condition) match(o *operands) bool {
+	for _, and := range c {
+		ok := true
+		for _, r := range and {
+			if !r.match(o) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// A relation is of the form "operand % mod = ranges" or, if not is set,
+// "operand % mod != ranges". A mod of 0 means no modulo is taken.
+type relation struct {
+	operand byte
+	mod     int
+	not     bool
+	ranges  [][2]int
+}
+
+func (r *relation) match(o *operands) bool {
+	var x int
+	switch r.operand {
+	case 'n':
+		// n is the absolute value of the number, which only equals the
+		// integers in the ranges if it has no fraction.
+		if o.t != 0 {
+			return r.not
+		}
+		x = o.i
+	case 'i':
+		x = o.i
+	case 'v':
+		x = o.v
+	case 'w':
+		x = o.w
+	case 'f':
+		x = o.f
+	case 't':
+		x = o.t
+	case 'c', 'e':
+		x = 0 // exponents are not supported
+	}
+	if r.mod > 0 {
+		x %= r.mod
+	}
+	for _, rg := range r.ranges {
+		if rg[0] <= x && x <= rg[1] {
+			return !r.not
+		}
+	}
+	return r.not
+}
+
+// parseCondition parses a condition of the CLDR plural rule syntax:
+//
+//	condition     = and_condition ('or' and_condition)*
+//	and_condition = relation ('and' relation)*
+//	relation      = expr ('=' | '!=') range_list
+//	expr          = operand ('%' value)?
+//	operand       = 'n' | 'i' | 'f' | 't' | 'v' | 'w' | 'c' | 'e'
+//	range_list    = (range | value) (',' range_list)*
+//	range         = value'..'value
+//
+// An empty condition never matches.
+func parseCondition(s string) (condition, error) {
+	if i := strings.IndexByte(s, '@'); i >= 0 {
+		s = s[:i]
+	}
+	p := &ruleParser{src: s, tokens: tokenize(s)}
+	var c condition
+	if len(p.tokens) == 0 {
+		return c, nil
+	}
+	for {
+		var and []relation
+		for {
+			r, err := p.relation()
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, r)
+			if !p.accept("and") {
+				break
+			}
+		}
+		c = append(c, and)
+		if !p.accept("or") {
+			break
+		}
+	}
+	if len(p.tokens) > 0 {
+		return nil, p.errorf("unexpected %q", p.tokens[0])
+	}
+	return c, nil
+}
+
+type ruleParser struct {
+	src    string
+	tokens []string
+}
+
+func (p *ruleParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("plural: invalid rule %q: %s", strings.TrimSpace(p.src), fmt.Sprintf(format, args...))
+}
+
+func (p *ruleParser) next() string {
+	if len(p.tokens) == 0 {
+		return ""
+	}
+	t := p.tokens[0]
+	p.tokens = p.tokens[1:]
+	return t
+}
+
+func (p *ruleParser) accept(tok string) bool {
+	if len(p.tokens) > 0 && p.tokens[0] == tok {
+		p.tokens = p.tokens[1:]
+		return true
+	}
+	return false
+}
+
+func (p *ruleParser) relation() (r relation, err error) {
+	switch op := p.next(); op {
+	case "n", "i", "v", "w", "f", "t", "c", "e":
+		r.operand = op[0]
+	case "":
+		return r, p.errorf("missing operand")
+	default:
+		return r, p.errorf("invalid operand %q", op)
+	}
+	if p.accept("%") {
+		if r.mod, err = p.value(); err != nil {
+			return r, err
+		}
+		if r.mod == 0 {
+			return r, p.errorf("modulo by zero")
+		}
+	}
+	switch tok := p.next(); tok {
+	case "=":
+	case "!=":
+		r.not = true
+	default:
+		return r, p.errorf("expected '=' or '!=', found %q", tok)
+	}
+	for {
+		lo, err := p.value()
+		if err != nil {
+			return r, err
+		}
+		hi := lo
+		if p.accept("..") {
+			if hi, err = p.value(); err != nil {
+				return r, err
+			}
+			if hi < lo {
+				return r, p.errorf("invalid range %d..%d", lo, hi)
+			}
+		}
+		r.ranges = append(r.ranges, [2]int{lo, hi})
+		if !p.accept(",") {
+			return r, nil
+		}
+	}
+}
+
+func (p *ruleParser) value() (int, error) {
+	tok := p.next()
+	v, err := strconv.ParseUint(tok, 10, 63)
+	if err != nil || v >= maxOperand {
+		return 0, p.errorf("invalid value %q", tok)
+	}
+	return int(v), nil
+}
+
+// tokenize splits s into operands, operators, keywords, and values.
+func tokenize(s string) (tokens []string) {
+	for i := 0; i < len(s); {
+		c := s[i]
+		j := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+			continue
+		case c == '!' || c == '.':
+			if j < len(s) && (s[j] == '=' || c == '.' && s[j] == '.') {
+				j++
+			}
+		case '0' <= c && c <= '9':
+			for j < len(s) && '0' <= s[j] && s[j] <= '9' {
+				j++
+			}
+		case 'a' <= c && c <= 'z':
+			for j < len(s) && 'a' <= s[j] && s[j] <= 'z' {
+				j++
+			}
+		}
+		tokens = append(tokens, s[i:j])
+		i = j
+	}
+	return tokens
+}
@@ -0,0 +1,167 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plural
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/internal/catmsg"
+	"golang.org/x/text/language"
+)
+
+// polish holds the CLDR cardinal rules for Polish.
+var polish = map[Form]string{
+	One:  "i = 1 and v = 0 @integer 1",
+	Few:  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+	Many: "v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14",
+}
+
+func TestRegister(t *testing.T) {
+	qaa := language.MustParse("qaa")
+	if err := Cardinal.Register(qaa, polish); err != nil {
+		t.Fatal(err)
+	}
+	pl := language.Polish
+	for _, scale := range []int{0, 1, 2} {
+		for n := 0; n < 300; n++ {
+			digits := mkDigits(fmt.Sprint(n))
+			exp := len(digits)
+			got := Cardinal.MatchDigits(qaa, digits, exp, scale)
+			want := Cardinal.MatchDigits(pl, digits, exp, scale)
+			if got != want {
+				t.Errorf("MatchDigits(%d, scale %d): got %v; want %v", n, scale, got, want)
+			}
+			got = Cardinal.MatchPlural(qaa, n, scale, 0, 0, 0)
+			want = Cardinal.MatchPlural(pl, n, scale, 0, 0, 0)
+			if got != want {
+				t.Errorf("MatchPlural(%d, scale %d): got %v; want %v", n, scale, got, want)
+			}
+		}
+	}
+
+	// Regional variants inherit the rules.
+	if got := Cardinal.MatchPlural(language.MustParse("qaa-PL"), 3, 0, 0, 0, 0); got != Few {
+		t.Errorf("qaa-PL: got %v; want %v", got, Few)
+	}
+	// Ordinal rules are not affected.
+	if got := Ordinal.Forms(qaa); !reflect.DeepEqual(got, []Form{Other}) {
+		t.Errorf("Ordinal.Forms(qaa): got %v; want [Other]", got)
+	}
+}
+
+func TestRegisterOperands(t *testing.T) {
+	x := language.MustParse("x-operands")
+	err := Cardinal.Register(x, map[Form]string{
+		Zero: "n = 0",
+		One:  "n % 1000000 = 1",
+		Two:  "t = 5 and v = 1",
+		Few:  "f = 50",
+		Many: "v = 3..4, 7",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		digits string
+		exp    int
+		scale  int
+		want   Form
+	}{
+		{"", 0, 0, Zero},
+		{"", 0, 2, Zero},
+		{"1", 1, 0, One},
+		{"1", 1, 1, One},
+		{"11", 1, 1, Other},
+		{"2000000000000000000001", 22, 0, One},
+		{"15", 1, 1, Two},
+		{"15", 1, 2, Few},
+		{"15", 1, 3, Many},
+		{"15", 1, 7, Many},
+		{"15", 1, 5, Other},
+	}
+	for _, tc := range testCases {
+		got := Cardinal.MatchDigits(x, mkDigits(tc.digits), tc.exp, tc.scale)
+		if got != tc.want {
+			t.Errorf("%s:%d:%d: got %v; want %v", tc.digits, tc.exp, tc.scale, got, tc.want)
+		}
+	}
+}
+
+func TestRegisterErrors(t *testing.T) {
+	testCases := []struct {
+		rule string
+		err  string
+	}{
+		{"n", "expected '=' or '!='"},
+		{"n = ", `invalid value ""`},
+		{"x = 1", `invalid operand "x"`},
+		{"n % 0 = 1", "modulo by zero"},
+		{"n = 5..2", "invalid range 5..2"},
+		{"n = 1 and", "missing operand"},
+		{"n = 1 n = 2", `unexpected "n"`},
+		{"n = 1.5", `unexpected "."`},
+		{"n = -1", `invalid value "-"`},
+	}
+	tag := language.MustParse("x-errors")
+	for _, tc := range testCases {
+		err := Cardinal.Register(tag, map[Form]string{One: tc.rule})
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%q: got error %v; want %q", tc.rule, err, tc.err)
+		}
+	}
+	if err := Cardinal.Register(tag, map[Form]string{Other: "n = 1"}); err == nil {
+		t.Errorf("rule for Other: unexpected success")
+	}
+	if f := Cardinal.Forms(tag); len(f) != 1 {
+		t.Errorf("failed Register changed rules of %v: %v", tag, f)
+	}
+}
+
+func TestForms(t *testing.T) {
+	testCases := []struct {
+		p    *Rules
+		tag  string
+		want []Form
+	}{
+		{Cardinal, "en", []Form{Other, One}},
+		{Cardinal, "pl", []Form{Other, One, Few, Many}},
+		{Cardinal, "ja", []Form{Other}},
+		{Cardinal, "ar", []Form{Other, Zero, One, Two, Few, Many}},
+		{Ordinal, "en", []Form{Other, One, Two, Few}},
+	}
+	for _, tc := range testCases {
+		got := tc.p.Forms(language.MustParse(tc.tag))
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %v; want %v", tc.tag, got, tc.want)
+		}
+	}
+}
+
+func TestRegisterSelect(t *testing.T) {
+	tag := language.MustParse("x-select")
+	if err := Ordinal.Register(tag, map[Form]string{Two: "n = 2"}); err != nil {
+		t.Fatal(err)
+	}
+	msg := SelectOrdinalf(1, "%d", "two", "second", "other", "nth")
+	data, err := catmsg.Compile(tag, nil, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for arg, want := range map[int]string{1: "nth", 2: "second", 22: "nth"} {
+		r := renderer{arg: arg}
+		if err := catmsg.NewDecoder(tag, &r, nil).Execute(data); err != nil {
+			t.Fatal(err)
+		}
+		if r.result != want {
+			t.Errorf("%d: got %q; want %q", arg, r.result, want)
+		}
+	}
+	if _, err := catmsg.Compile(tag, nil, SelectOrdinalf(1, "%d", "one", "first")); err == nil {
+		t.Errorf("unregistered form: unexpected success")
+	}
+}
@@ -103,6 +103,7 @@ const (
 	msgRaw
 	msgString
 	msgAffix
+	msgArgNames
 	// Leave some arbitrary room for future expansion: 20 should suffice.
 	numInternal = 20
 )
@@ -113,11 +114,12 @@ var (
 	// TODO: find a more stable way to link handles to message types.
 	mutex sync.Mutex
 	names = map[string]Handle{
-		prefix + "Vars":   msgVars,
-		prefix + "First":  msgFirst,
-		prefix + "Raw":    msgRaw,
-		prefix + "String": msgString,
-		prefix + "Affix":  msgAffix,
+		prefix + "Vars":     msgVars,
+		prefix + "First":    msgFirst,
+		prefix + "Raw":      msgRaw,
+		prefix + "String":   msgString,
+		prefix + "Affix":    msgAffix,
+		prefix + "ArgNames": msgArgNames,
 	}
 	handlers = make([]Handler, numInternal)
 )
@@ -164,6 +166,17 @@ func init() {
 		return true
 	}
 
+	// ArgNames passes the names of the arguments to the Renderer. It does not
+	// produce output, so that the next message in a sequence is evaluated.
+	handlers[msgArgNames] = func(d *Decoder) bool {
+		names := make([]string, d.DecodeUint())
+		for i := range names {
+			names[i] = d.DecodeString()
+		}
+		d.SetArgNames(names)
+		return false
+	}
+
 	handlers[msgAffix] = func(d *Decoder) bool {
 		// TODO: use an alternative method for common cases.
 		prefix := d.DecodeString()
@@ -395,6 +408,23 @@ func (s String) Compile(e *Encoder) (err error) {
 	return err
 }
 
+// ArgNames is a message that sets the names of the substitution arguments,
+// where the i-th name is the name of argument i+1. It allows a Renderer that
+// implements ArgNamer to accept arguments by name. ArgNames does not produce
+// any output and should precede the other messages in a sequence.
+type ArgNames []string
+
+// Compile implements Message. It returns ErrIncomplete, as ArgNames never
+// produces a result by itself.
+func (a ArgNames) Compile(e *Encoder) (err error) {
+	e.EncodeMessageType(msgArgNames)
+	e.EncodeUint(uint64(len(a)))
+	for _, s := range a {
+		e.EncodeString(s)
+	}
+	return ErrIncomplete
+}
+
 // Affix is a message that adds a prefix and suffix to another message.
 // This is mostly used add back whitespace to a translation that was stripped
 // before sending it out.
@@ -246,6 +246,44 @@ func TestCodec(t *testing.T) {
 	}
 }
 
+type namer struct {
+	renderer
+	names []string
+}
+
+func (r *namer) SetArgNames(names []string) { r.names = names }
+
+func TestArgNames(t *testing.T) {
+	m := FirstOf{ArgNames{"user", "", "count"}, String("foo")}
+	data, err := Compile(language.Und, macros, m)
+	if err != nil {
+		t.Fatalf("Compile: unexpected error: %v", err)
+	}
+	if _, err := Compile(language.Und, macros, ArgNames{"x"}); err != ErrIncomplete {
+		t.Errorf("Compile(ArgNames): got error %v; want %v", err, ErrIncomplete)
+	}
+
+	r := &namer{}
+	if err := NewDecoder(language.Und, r, macros).Execute(data); err != nil {
+		t.Fatalf("Execute: unexpected error: %v", err)
+	}
+	if want := []string{"user", "", "count"}; strings.Join(r.names, ",") != strings.Join(want, ",") {
+		t.Errorf("names: got %q; want %q", r.names, want)
+	}
+	if r.result != "foo" {
+		t.Errorf("result: got %q; want %q", r.result, "foo")
+	}
+
+	// Renderers that do not accept names ignore the message.
+	r2 := &renderer{}
+	if err := NewDecoder(language.Und, r2, macros).Execute(data); err != nil {
+		t.Fatalf("Execute: unexpected error: %v", err)
+	}
+	if r2.result != "foo" {
+		t.Errorf("result: got %q; want %q", r2.result, "foo")
+	}
+}
+
 func failErr(got error, want string) bool {
 	if got == nil {
 		return want != ""
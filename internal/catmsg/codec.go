@@ -24,6 +24,16 @@ type Renderer interface {
 	Arg(i int) interface{}
 }
 
+// An ArgNamer is a Renderer that accepts substitution arguments by name.
+type ArgNamer interface {
+	Renderer
+
+	// SetArgNames is called with the names of the arguments of a message
+	// before the parts of the message that refer to them are rendered, where
+	// the i-th name is the name of argument i+1.
+	SetArgNames(names []string)
+}
+
 // A Dictionary specifies a source of messages, including variables or macros.
 type Dictionary interface {
 	// Lookup returns the message for the given key. It returns false for ok if
@@ -267,6 +277,14 @@ func (d *Decoder) Done() bool { return len(d.data) == 0 }
 // Render implements Renderer.
 func (d *Decoder) Render(s string) { d.dst.Render(s) }
 
+// SetArgNames passes the names of the arguments to the Renderer if it
+// implements ArgNamer.
+func (d *Decoder) SetArgNames(names []string) {
+	if r, ok := d.dst.(ArgNamer); ok {
+		r.SetArgNames(names)
+	}
+}
+
 // Arg implements Renderer.
 //
 // During evaluation of macros, the argument positions may be mapped to
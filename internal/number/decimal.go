@@ -8,6 +8,7 @@ package number
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -336,15 +337,19 @@ func (d *Decimal) Convert(r RoundingContext, number interface{}) {
 		d.ConvertInt(r, unsigned, uint64(f))
 	case uint64:
 		d.ConvertInt(r, unsigned, f)
+	case string:
+		d.ConvertString(r, f)
+	case *big.Int:
+		d.ConvertBigInt(r, f)
+	case *big.Float:
+		d.ConvertBigFloat(r, f)
+	case *big.Rat:
+		d.ConvertRat(r, f)
 
 	default:
 		d.NaN = true
 		// TODO:
-		// case string: if produced by strconv, allows for easy arbitrary pos.
 		// case reflect.Value:
-		// case big.Float
-		// case big.Int
-		// case big.Rat?
 		// catch underlyings using reflect or will this already be done by the
 		//    message package?
 	}
@@ -390,7 +395,7 @@ func (d *Decimal) ConvertFloat(r RoundingContext, x float64, size int) {
 		// issues.
 		x *= mult
 		x /= float64(r.Increment)
-		x = r.Mode.roundFloat(x)
+		x = r.Mode.roundFloat(snapFloat(x))
 		x *= float64(r.Increment)
 		x /= mult
 	}
@@ -417,6 +422,11 @@ func (d *Decimal) ConvertFloat(r RoundingContext, x float64, size int) {
 			prec = n
 			verb = 'f'
 		}
+	} else if r.Increment > 0 {
+		// x was already rounded to the increment above. Only remove the error
+		// introduced by the floating-point arithmetic to avoid rounding twice.
+		prec = int(r.IncrementScale)
+		verb = 'f'
 	} else {
 		// TODO: At this point strconv's rounding is imprecise to the point that
 		// it is not usable for this purpose.
@@ -430,11 +440,12 @@ func (d *Decimal) ConvertFloat(r RoundingContext, x float64, size int) {
 		hasPrec := r.RoundSignificantDigits() >= 0
 		hasScale := r.RoundFractionDigits() >= 0
 		if hasPrec || hasScale {
-			// prec is the number of mantissa bits plus some extra for safety.
-			// We need at least the number of mantissa bits as decimals to
-			// accurately represent the floating point without rounding, as each
-			// bit requires one more decimal to represent: 0.5, 0.25, 0.125, ...
-			prec = 60
+			// Rounding the exact binary value would expose its representation
+			// error: 0.07 is slightly larger than 7/100 and would round to 0.08
+			// when rounding away from zero. Instead, we ask for the number of
+			// digits to which any decimal survives a round trip through a
+			// float64 and leave the rounding to the caller.
+			prec = float64Digits
 		}
 	}
 
@@ -471,6 +482,22 @@ func (d *Decimal) ConvertFloat(r RoundingContext, x float64, size int) {
 	}
 }
 
+// float64Digits is the number of significant decimal digits to which any
+// decimal survives a round trip through a float64.
+const float64Digits = 15
+
+// snapFloat rounds x to float64Digits significant digits. This removes the
+// error introduced by floating-point arithmetic, such as in 0.07 * 100 =
+// 7.000000000000001, before a number is rounded to an integer.
+func snapFloat(x float64) float64 {
+	var buf [32]byte
+	f, err := strconv.ParseFloat(string(strconv.AppendFloat(buf[:0], x, 'g', float64Digits, 64)), 64)
+	if err != nil {
+		return x
+	}
+	return f
+}
+
 func (d *Decimal) fillIntDigits(x uint64) {
 	if cap(d.Digits) < maxIntDigits {
 		d.Digits = d.buf[:]
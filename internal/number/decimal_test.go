@@ -7,6 +7,7 @@ package number
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"testing"
@@ -247,6 +248,8 @@ func TestConvert(t *testing.T) {
 	scale2away.SetScale(2)
 	inc0_05 := RoundingContext{Increment: 5, IncrementScale: 2}
 	inc0_05.SetScale(2)
+	inc0_01away := RoundingContext{Increment: 1, IncrementScale: 2, Mode: AwayFromZero}
+	inc0_01away.SetScale(2)
 	inc50 := RoundingContext{Increment: 50}
 	incScaleEqualToScalesLen := RoundingContext{Increment: 1, IncrementScale: 0}
 	if len(scales) <= math.MaxUint8 {
@@ -276,12 +279,13 @@ func TestConvert(t *testing.T) {
 		{uint64(234), scale2, "234"},
 		{uint(234), scale2, "234"},
 		{-1e9, scale2, "-1000000000.00"},
-		// The following two causes this result to have a lot of digits:
-		// 1) 0.234 cannot be accurately represented as a float64, and
-		// 2) as strconv does not support the rounding AwayFromZero, Convert
-		//    leaves the rounding to caller.
-		{0.234, scale2away,
-			"0.2340000000000000135447209004269097931683063507080078125"},
+		// As strconv does not support the rounding AwayFromZero, Convert
+		// leaves the rounding to caller. It does remove the representation
+		// error: 0.07 is slightly larger than 7/100 and would otherwise round
+		// to 0.08.
+		{0.234, scale2away, "0.234"},
+		{0.07, scale2away, "0.07"},
+		{0.1 + 0.2, scale2away, "0.3"},
 
 		{0.0249, inc0_05, "0.00"},
 		{0.025, inc0_05, "0.00"},
@@ -293,6 +297,9 @@ func TestConvert(t *testing.T) {
 		{0.0749, inc0_05, "0.05"},
 		{0.075, inc0_05, "0.10"},
 		{0.0751, inc0_05, "0.10"},
+		{1.001, inc0_01away, "1.01"},
+		{-0.001, inc0_01away, "-0.01"},
+		{0.07, inc0_01away, "0.07"},
 		{324, inc50, "300"},
 		{325, inc50, "300"},
 		{326, inc50, "350"},
@@ -314,6 +321,61 @@ func TestConvert(t *testing.T) {
 		{math.NaN(), inc50, "NaN"},
 		{"clearly not a number", scale2, "NaN"},
 		{0.0, incScaleEqualToScalesLen, "0"},
+
+		{"12345678901234567890.123456789", scale2, "12345678901234567890.123456789"},
+		{"-0.00120", scale2, "-0.00120"},
+		{"+1.5e3", scale2, "1500"},
+		{"1E-3", scale2, "0.001"},
+		{"-0.0", scale2, "0"},
+		{".5", scale2, "0.5"},
+		{"5.", scale2, "5"},
+		{"-Inf", scale2, "-Inf"},
+		{"infinity", scale2, "Inf"},
+		{"NaN", scale2, "NaN"},
+		{"", scale2, "NaN"},
+		{".", scale2, "NaN"},
+		{"1e", scale2, "NaN"},
+		{"1.2.3", scale2, "NaN"},
+		{"1/3", scale2, "NaN"},
+		{"1e99999999999", scale2, "NaN"},
+		{"0.025", inc0_05, "0"},
+		{"0.0250000000000000000001", inc0_05, "0.05"},
+		{"-0.075", inc0_05, "-0.10"},
+		{"-0.001", inc0_05, "0"},
+		{"1.001", inc0_01away, "1.01"},
+		{"349", inc50, "350"},
+		{"1e-20000", inc0_01away, "0.01"},
+		{"1e-20000", inc0_05, "0"},
+		{"1e20000", inc0_05, "1" + strings.Repeat("0", 20000)},
+
+		{big.NewInt(-234), scale2, "-234"},
+		{mkbigint("123456789012345678901234567890"), scale2, "123456789012345678901234567890"},
+		{big.NewInt(0), scale2, "0"},
+		{big.NewInt(325), inc50, "300"},
+		{big.NewInt(-375), inc50, "-400"},
+		{(*big.Int)(nil), scale2, "NaN"},
+
+		{big.NewFloat(0.1), scale2, "0.1"},
+		{big.NewFloat(-1.5e300), scale2, "-15" + strings.Repeat("0", 299)},
+		{mkbigfloat("1.00000000000000000000001"), scale2, "1.00000000000000000000001"},
+		{big.NewFloat(math.Inf(-1)), scale2, "-Inf"},
+		{big.NewFloat(0.075), inc0_05, "0.10"},
+		{(*big.Float)(nil), scale2, "NaN"},
+
+		{big.NewRat(1, 8), scale2, "0.125"},
+		{big.NewRat(-5, 2), scale2, "-2.5"},
+		{big.NewRat(100, 1), scale2, "100"},
+		{big.NewRat(1, 3), scale2, "0.3331"},
+		{big.NewRat(2, 3), RoundingContext{MaxSignificantDigits: 3, MaxFractionDigits: 6}, "0.66661"},
+		{big.NewRat(200000, 3), RoundingContext{MaxSignificantDigits: 3, MaxFractionDigits: 6}, "66666.1"},
+		{big.NewRat(1, 30000), scale2, "0.0001"},
+		{big.NewRat(1, 3), RoundingContext{MaxFractionDigits: -1}, "0.3333333333333333333333333333333333"},
+		{big.NewRat(2, 3), RoundingContext{MaxFractionDigits: -1}, "0.6666666666666666666666666666666667"},
+		{big.NewRat(3, 40), inc0_05, "0.10"},
+		{big.NewRat(1, 40), inc0_05, "0"},
+		{big.NewRat(1, 3), inc0_01away, "0.34"},
+		{big.NewRat(-1, 3), inc0_01away, "-0.34"},
+		{(*big.Rat)(nil), scale2, "NaN"},
 	}
 	for _, tc := range testCases {
 		var d Decimal
@@ -326,6 +388,16 @@ func TestConvert(t *testing.T) {
 	}
 }
 
+func mkbigint(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 10)
+	return x
+}
+
+func mkbigfloat(s string) *big.Float {
+	x, _, _ := big.ParseFloat(s, 10, 100, big.ToNearestEven)
+	return x
+}
+
 type converter int
 
 func (c converter) Convert(d *Decimal, r RoundingContext) {
@@ -0,0 +1,356 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// This file implements the exact conversion of arbitrary-precision numbers:
+// the types of package math/big and decimal strings.
+
+const (
+	// maxExactExp is the maximum magnitude of the exponent of a decimal
+	// string for which rounding to an increment is computed from all its
+	// digits.
+	maxExactExp = 10000
+
+	// maxStringExp is the maximum magnitude of the exponent of a decimal
+	// string.
+	maxStringExp = math.MaxInt32 / 2
+
+	// defaultRatDigits is the number of significant digits to which a
+	// rational number without a finite decimal representation is rounded if
+	// the RoundingContext does not define a precision. It is the precision of
+	// the IEEE 754 decimal128 format.
+	defaultRatDigits = 34
+)
+
+var (
+	bigOne = big.NewInt(1)
+	bigTen = big.NewInt(10)
+)
+
+// ConvertString converts a decimal string, such as "-1234.5678" or "1.5e-7",
+// to decimals. Apart from digits, the string may contain a leading sign, a
+// decimal point, and an exponent. The special values "Inf", "Infinity", and
+// "NaN" are recognized, ignoring case. Any other string converts to NaN.
+//
+// The conversion is exact, so any digits beyond the precision of a float64
+// are retained.
+func (d *Decimal) ConvertString(r RoundingContext, s string) {
+	d.clear()
+	if !d.setString(s) {
+		d.clear()
+		d.NaN = true
+		return
+	}
+	if r.Increment == 0 || d.NaN || d.Inf || len(d.Digits) == 0 {
+		return
+	}
+	t := int(d.Exp) - len(d.Digits)
+	if t > maxExactExp {
+		// The number is an integer too large for the increment to matter.
+		return
+	}
+	num := new(big.Int)
+	if t < -maxExactExp {
+		// The number is smaller than any increment. Any number of that size
+		// is rounded the same way.
+		num.SetInt64(1)
+		t = -maxExactExp
+	} else {
+		for _, c := range d.Digits {
+			num.Mul(num, bigTen)
+			num.Add(num, big.NewInt(int64(c)))
+		}
+	}
+	den := bigOne
+	if t >= 0 {
+		num.Mul(num, pow10(t))
+	} else {
+		den = pow10(-t)
+	}
+	d.roundToIncrement(r, num, den)
+}
+
+// setString sets d to the value of the decimal string s. It reports whether s
+// is a valid number.
+func (d *Decimal) setString(s string) bool {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		d.Neg = s[0] == '-'
+		s = s[1:]
+	}
+	switch {
+	case strings.EqualFold(s, "inf"), strings.EqualFold(s, "infinity"):
+		d.Inf = true
+		return true
+	case strings.EqualFold(s, "nan"):
+		d.NaN = true
+		d.Neg = false
+		return true
+	}
+	b := d.Digits[:0]
+	exp := 0
+	sawDigit, sawDot := false, false
+	i := 0
+loop:
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			sawDigit = true
+			if c == '0' && len(b) == 0 {
+				// Strip leading zeros.
+				if sawDot {
+					exp--
+				}
+				continue
+			}
+			b = append(b, c-'0')
+			if !sawDot {
+				exp++
+			}
+		case c == '.' && !sawDot:
+			sawDot = true
+		default:
+			break loop
+		}
+	}
+	if !sawDigit {
+		return false
+	}
+	if i < len(s) {
+		if s[i] != 'e' && s[i] != 'E' {
+			return false
+		}
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > maxStringExp || e < -maxStringExp {
+			return false
+		}
+		exp += e
+	}
+	if len(b) == 0 {
+		// Zero is unsigned, as it is for the other numeric types.
+		d.Neg = false
+		exp = 0
+	} else if exp > maxStringExp || exp < -maxStringExp {
+		return false
+	}
+	d.Digits = b
+	d.Exp = int32(exp)
+	return true
+}
+
+// ConvertBigInt converts a big.Int to decimals. A nil value converts to NaN.
+func (d *Decimal) ConvertBigInt(r RoundingContext, x *big.Int) {
+	d.clear()
+	if x == nil {
+		d.NaN = true
+		return
+	}
+	d.Neg = x.Sign() < 0
+	num := new(big.Int).Abs(x)
+	if r.Increment > 0 {
+		d.roundToIncrement(r, num, bigOne)
+		return
+	}
+	d.setInt(num, 0)
+}
+
+// ConvertBigFloat converts a big.Float to decimals using the shortest decimal
+// representation that identifies x at its precision, which is the same
+// representation that x.Text('g', -1) would produce. A nil value converts to
+// NaN.
+func (d *Decimal) ConvertBigFloat(r RoundingContext, x *big.Float) {
+	switch {
+	case x == nil:
+		d.clear()
+		d.NaN = true
+	case x.IsInf():
+		d.clear()
+		d.Inf = true
+		d.Neg = x.Signbit()
+	default:
+		var buf [64]byte
+		d.ConvertString(r, string(x.Append(buf[:0], 'g', -1)))
+	}
+}
+
+// ConvertRat converts a big.Rat to decimals. Numbers that have a finite
+// decimal representation, such as 1/8, are converted exactly. Other numbers,
+// such as 1/3, are converted to just enough digits to be rounded correctly
+// according to r or, if r does not limit the number of digits, to 34
+// significant digits. A nil value converts to NaN.
+func (d *Decimal) ConvertRat(r RoundingContext, x *big.Rat) {
+	d.clear()
+	if x == nil {
+		d.NaN = true
+		return
+	}
+	d.Neg = x.Sign() < 0
+	num := new(big.Int).Abs(x.Num())
+	den := x.Denom()
+	if r.Increment > 0 {
+		d.roundToIncrement(r, num, den)
+		return
+	}
+	k, exact := decimalScale(den)
+	limited := true
+	if !exact {
+		k, limited = r.ratScale(num, den)
+	}
+	rem := new(big.Int)
+	num.Mul(num, pow10(k))
+	num.QuoRem(num, den, rem)
+	d.setInt(num, k)
+	if rem.Sign() == 0 {
+		return
+	}
+	// Append a sticky digit to indicate that the number is larger than the
+	// digits converted so far. This ensures that a subsequent rounding step
+	// rounds correctly.
+	if len(d.Digits) == 0 {
+		d.Exp = int32(-k)
+	}
+	d.Digits = append(d.Digits, 1)
+	if !limited {
+		d.round(r.Mode, defaultRatDigits)
+	}
+}
+
+// ratScale returns the number of fraction digits to which num/den needs to be
+// converted so that it can be rounded correctly according to r. It reports
+// whether r limits the number of digits. If not, the number of fraction digits
+// suffices to represent at least defaultRatDigits significant digits.
+func (r *RoundingContext) ratScale(num, den *big.Int) (scale int, limited bool) {
+	// minExp is a lower bound for the exponent of num/den as a Decimal.
+	minExp := numDigitsMin(num) - numDigitsMax(den)
+
+	if r.isScientific() {
+		if n := int(r.MaxFractionDigits); n >= 0 {
+			numInt := int(r.MaxIntegerDigits)
+			if numInt < int(r.MinIntegerDigits) {
+				numInt = int(r.MinIntegerDigits)
+			}
+			if numInt < 1 {
+				numInt = 1
+			}
+			scale, limited = n+numInt-minExp, true
+		}
+	} else if n := int(r.MaxFractionDigits); n >= 0 {
+		scale, limited = n+int(r.DigitShift), true
+	}
+	if n := int(r.MaxSignificantDigits); n > 0 && (r.isScientific() || r.MaxIntegerDigits == 0) {
+		s := n - minExp
+		if !limited ||
+			r.Priority == LessPrecision && s < scale ||
+			r.Priority == MorePrecision && s > scale {
+			scale, limited = s, true
+		}
+	}
+	if !limited {
+		scale = defaultRatDigits - minExp
+	}
+	// Include one more digit to make the rounding decision.
+	scale++
+	if scale < 0 {
+		scale = 0
+	}
+	return scale, limited
+}
+
+// roundToIncrement sets d to the non-negative number num/den rounded to a
+// multiple of the increment defined by r, retaining the sign of d.
+func (d *Decimal) roundToIncrement(r RoundingContext, num, den *big.Int) {
+	scale := int(r.IncrementScale)
+	inc := big.NewInt(int64(r.Increment))
+
+	// Compute the number of increments, (num * 10^scale) / (den * inc).
+	n := new(big.Int).Mul(num, pow10(scale))
+	m := new(big.Int).Mul(den, inc)
+	q, rem := n.QuoRem(n, m, new(big.Int))
+	if rem.Sign() != 0 {
+		half := rem.Lsh(rem, 1).Cmp(m)
+		up := false
+		switch r.Mode {
+		case ToNegativeInf:
+			up = d.Neg
+		case ToPositiveInf:
+			up = !d.Neg
+		case ToZero:
+			// nothing to do
+		case AwayFromZero:
+			up = true
+		case ToNearestEven:
+			up = half > 0 || half == 0 && q.Bit(0) != 0
+		case ToNearestAway:
+			up = half >= 0
+		case ToNearestZero:
+			up = half > 0
+		default:
+			panic("unreachable")
+		}
+		if up {
+			q.Add(q, bigOne)
+		}
+	}
+	d.setInt(q.Mul(q, inc), scale)
+	if len(d.Digits) == 0 {
+		d.Neg = false
+	}
+}
+
+// setInt sets the digits of d to those of x * 10^-scale, where x >= 0.
+func (d *Decimal) setInt(x *big.Int, scale int) {
+	if x.Sign() == 0 {
+		d.Digits = d.Digits[:0]
+		d.Exp = 0
+		return
+	}
+	b := x.Append(d.Digits[:0], 10)
+	for i := range b {
+		b[i] -= '0'
+	}
+	d.Digits = b
+	d.Exp = int32(len(b) - scale)
+}
+
+// decimalScale returns the number of fraction digits of 1/den. It reports
+// false if 1/den does not have a finite decimal representation, that is, if
+// den has prime factors other than 2 and 5.
+func decimalScale(den *big.Int) (scale int, ok bool) {
+	twos := int(den.TrailingZeroBits())
+	m := new(big.Int).Rsh(den, uint(twos))
+	fives := 0
+	five := big.NewInt(5)
+	for q, rem := new(big.Int), new(big.Int); m.Cmp(bigOne) > 0; fives++ {
+		if q.QuoRem(m, five, rem); rem.Sign() != 0 {
+			return 0, false
+		}
+		m, q = q, m
+	}
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
+
+// numDigitsMin and numDigitsMax return a lower and upper bound, respectively,
+// for the number of decimal digits of x, where x > 0.
+
+func numDigitsMin(x *big.Int) int {
+	return int(float64(x.BitLen()-1)*math.Log10(2)) + 1
+}
+
+func numDigitsMax(x *big.Int) int {
+	return int(float64(x.BitLen())*math.Log10(2)) + 1
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
@@ -6,6 +6,8 @@ package number
 
 import (
 	"strconv"
+	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"golang.org/x/text/language"
@@ -35,6 +37,34 @@ type VisibleDigits interface {
 type Formatter struct {
 	Pattern
 	Info
+
+	// Parts, if not nil, receives the parts of the formatted numbers, with
+	// byte offsets relative to the start of the buffer passed to the Append,
+	// Format, and Render methods.
+	Parts *[]Part
+
+	// Currency, if not empty, is the currency symbol that replaces the
+	// currency sign ¤ in the affixes of the pattern. CurrencyDecimal and
+	// CurrencyGroup, if not empty, replace the decimal and grouping separators
+	// of the language for formatting currency amounts.
+	Currency        string
+	CurrencyDecimal string
+	CurrencyGroup   string
+}
+
+// separator returns the decimal separator if decimal is true and the grouping
+// separator otherwise.
+func (f *Formatter) separator(decimal bool) string {
+	if decimal {
+		if f.CurrencyDecimal != "" {
+			return f.CurrencyDecimal
+		}
+		return f.Symbol(SymDecimal)
+	}
+	if f.CurrencyGroup != "" {
+		return f.CurrencyGroup
+	}
+	return f.Symbol(SymGroup)
 }
 
 func (f *Formatter) init(t language.Tag, index []uint8) {
@@ -115,8 +145,8 @@ func (f *Formatter) Render(dst []byte, d Digits) []byte {
 		return result
 	}
 	width := int(f.FormatWidth)
-	if count := utf8.RuneCount(result); count < width {
-		insertPos := 0
+	if count := utf8.RuneCount(result[len(dst):]); count < width {
+		insertPos := len(dst)
 		switch f.Flags & PadMask {
 		case PadAfterPrefix:
 			insertPos = postPrefix
@@ -141,6 +171,7 @@ func (f *Formatter) Render(dst []byte, d Digits) []byte {
 			copy(buf[insertPos+extra:], result[insertPos:])
 			result = buf
 		}
+		f.insertPart(LiteralPart, insertPos, extra)
 		for ; num > 0; num-- {
 			insertPos += copy(result[insertPos:], pad[:sz])
 		}
@@ -184,14 +215,21 @@ func decimalVisibleDigits(r RoundingContext, d *Decimal) Digits {
 		p = maxSig
 	}
 	if maxFrac := int(r.MaxFractionDigits); maxFrac >= 0 {
-		if cap := int(exp) + maxFrac; cap < p {
-			p = int(exp) + maxFrac
+		cap := int(exp) + maxFrac
+		if r.Priority == MorePrecision && r.MaxSignificantDigits > 0 {
+			if cap > p {
+				p = cap
+			}
+		} else if cap < p {
+			p = cap
 		}
 		if p < 0 {
 			p = 0
 		}
 	}
+	before := n.Exp
 	n.round(r.Mode, p)
+	exp += n.Exp - before // rounding may carry into a new digit
 
 	// set End (trailing zeros)
 	n.End = int32(len(n.Digits))
@@ -242,7 +280,7 @@ func appendDecimal(dst []byte, f *Formatter, n *Digits) (b []byte, postPre, preS
 
 	neg := n.Neg
 	affix, suffix := f.getAffixes(neg)
-	dst = appendAffix(dst, f, affix, neg)
+	dst = appendAffix(dst, f, affix, neg, prefixSpacing)
 	savedLen := len(dst)
 
 	minInt := int(f.MinIntegerDigits)
@@ -251,41 +289,41 @@ func appendDecimal(dst []byte, f *Formatter, n *Digits) (b []byte, postPre, preS
 	}
 	// add leading zeros
 	for i := minInt; i > numInt; i-- {
-		dst = f.AppendDigit(dst, 0)
+		dst = f.appendDigitPart(dst, IntegerPart, 0)
 		if f.needsSep(i) {
-			dst = append(dst, f.Symbol(SymGroup)...)
+			dst = f.appendPart(dst, GroupPart, f.separator(false))
 		}
 	}
 	i := 0
 	for ; i < len(intDigits); i++ {
-		dst = f.AppendDigit(dst, intDigits[i])
+		dst = f.appendDigitPart(dst, IntegerPart, intDigits[i])
 		if f.needsSep(numInt - i) {
-			dst = append(dst, f.Symbol(SymGroup)...)
+			dst = f.appendPart(dst, GroupPart, f.separator(false))
 		}
 	}
 	for ; i < numInt; i++ {
-		dst = f.AppendDigit(dst, 0)
+		dst = f.appendDigitPart(dst, IntegerPart, 0)
 		if f.needsSep(numInt - i) {
-			dst = append(dst, f.Symbol(SymGroup)...)
+			dst = f.appendPart(dst, GroupPart, f.separator(false))
 		}
 	}
 
 	if numFrac > 0 || f.Flags&AlwaysDecimalSeparator != 0 {
-		dst = append(dst, f.Symbol(SymDecimal)...)
+		dst = f.appendPart(dst, DecimalPart, f.separator(true))
 	}
 	// Add trailing zeros
 	i = 0
 	for n := -int(n.Exp); i < n; i++ {
-		dst = f.AppendDigit(dst, 0)
+		dst = f.appendDigitPart(dst, FractionPart, 0)
 	}
 	for _, d := range fracDigits {
 		i++
-		dst = f.AppendDigit(dst, d)
+		dst = f.appendDigitPart(dst, FractionPart, d)
 	}
 	for ; i < numFrac; i++ {
-		dst = f.AppendDigit(dst, 0)
+		dst = f.appendDigitPart(dst, FractionPart, 0)
 	}
-	return appendAffix(dst, f, suffix, neg), savedLen, len(dst)
+	return appendAffix(dst, f, suffix, neg, suffixSpacing), savedLen, len(dst)
 }
 
 func scientificVisibleDigits(r RoundingContext, d *Decimal) Digits {
@@ -327,8 +365,15 @@ func scientificVisibleDigits(r RoundingContext, d *Decimal) Digits {
 	if maxSig := int(r.MaxSignificantDigits); maxSig > 0 {
 		p = maxSig
 	}
-	if maxFrac := int(r.MaxFractionDigits); maxFrac >= 0 && numInt+maxFrac < p {
-		p = numInt + maxFrac
+	if maxFrac := int(r.MaxFractionDigits); maxFrac >= 0 {
+		cap := numInt + maxFrac
+		if r.Priority == MorePrecision && r.MaxSignificantDigits > 0 {
+			if cap > p {
+				p = cap
+			}
+		} else if cap < p {
+			p = cap
+		}
 	}
 	n.round(r.Mode, p)
 
@@ -337,6 +382,9 @@ func scientificVisibleDigits(r RoundingContext, d *Decimal) Digits {
 	if minSig := int32(r.MinFractionDigits) + int32(numInt); n.End < minSig {
 		n.End = minSig
 	}
+	if minSig := int32(r.MinSignificantDigits); n.End < minSig {
+		n.End = minSig
+	}
 	return n
 }
 
@@ -359,32 +407,32 @@ func appendScientific(dst []byte, f *Formatter, n *Digits) (b []byte, postPre, p
 	}
 	neg := n.Neg
 	affix, suffix := f.getAffixes(neg)
-	dst = appendAffix(dst, f, affix, neg)
+	dst = appendAffix(dst, f, affix, neg, prefixSpacing)
 	savedLen := len(dst)
 
 	i := 0
 	for ; i < len(intDigits); i++ {
-		dst = f.AppendDigit(dst, intDigits[i])
+		dst = f.appendDigitPart(dst, IntegerPart, intDigits[i])
 		if f.needsSep(numInt - i) {
-			dst = append(dst, f.Symbol(SymGroup)...)
+			dst = f.appendPart(dst, GroupPart, f.separator(false))
 		}
 	}
 	for ; i < numInt; i++ {
-		dst = f.AppendDigit(dst, 0)
+		dst = f.appendDigitPart(dst, IntegerPart, 0)
 		if f.needsSep(numInt - i) {
-			dst = append(dst, f.Symbol(SymGroup)...)
+			dst = f.appendPart(dst, GroupPart, f.separator(false))
 		}
 	}
 
 	if numFrac > 0 || f.Flags&AlwaysDecimalSeparator != 0 {
-		dst = append(dst, f.Symbol(SymDecimal)...)
+		dst = f.appendPart(dst, DecimalPart, f.separator(true))
 	}
 	i = 0
 	for ; i < len(fracDigits); i++ {
-		dst = f.AppendDigit(dst, fracDigits[i])
+		dst = f.appendDigitPart(dst, FractionPart, fracDigits[i])
 	}
 	for ; i < numFrac; i++ {
-		dst = f.AppendDigit(dst, 0)
+		dst = f.appendDigitPart(dst, FractionPart, 0)
 	}
 
 	// exp
@@ -394,41 +442,43 @@ func appendScientific(dst []byte, f *Formatter, n *Digits) (b []byte, postPre, p
 	exp := n.Exp - int32(n.Comma)
 	exponential := f.Symbol(SymExponential)
 	if exponential == "E" {
+		start := len(dst)
 		dst = append(dst, f.Symbol(SymSuperscriptingExponent)...)
 		dst = f.AppendDigit(dst, 1)
 		dst = f.AppendDigit(dst, 0)
+		f.mark(ExponentSeparatorPart, start, dst)
 		switch {
 		case exp < 0:
-			dst = append(dst, superMinus...)
+			dst = f.appendPart(dst, ExponentMinusSignPart, superMinus)
 			exp = -exp
 		case f.Flags&AlwaysExpSign != 0:
-			dst = append(dst, superPlus...)
+			dst = f.appendPart(dst, ExponentPlusSignPart, superPlus)
 		}
-		b = strconv.AppendUint(buf[:0], uint64(exp), 10)
+		b := strconv.AppendUint(buf[:0], uint64(exp), 10)
 		for i := len(b); i < int(f.MinExponentDigits); i++ {
-			dst = append(dst, superDigits[0]...)
+			dst = f.appendPart(dst, ExponentIntegerPart, superDigits[0])
 		}
 		for _, c := range b {
-			dst = append(dst, superDigits[c-'0']...)
+			dst = f.appendPart(dst, ExponentIntegerPart, superDigits[c-'0'])
 		}
 	} else {
-		dst = append(dst, exponential...)
+		dst = f.appendPart(dst, ExponentSeparatorPart, exponential)
 		switch {
 		case exp < 0:
-			dst = append(dst, f.Symbol(SymMinusSign)...)
+			dst = f.appendPart(dst, ExponentMinusSignPart, f.Symbol(SymMinusSign))
 			exp = -exp
 		case f.Flags&AlwaysExpSign != 0:
-			dst = append(dst, f.Symbol(SymPlusSign)...)
+			dst = f.appendPart(dst, ExponentPlusSignPart, f.Symbol(SymPlusSign))
 		}
-		b = strconv.AppendUint(buf[:0], uint64(exp), 10)
+		b := strconv.AppendUint(buf[:0], uint64(exp), 10)
 		for i := len(b); i < int(f.MinExponentDigits); i++ {
-			dst = f.AppendDigit(dst, 0)
+			dst = f.appendDigitPart(dst, ExponentIntegerPart, 0)
 		}
 		for _, c := range b {
-			dst = f.AppendDigit(dst, c-'0')
+			dst = f.appendDigitPart(dst, ExponentIntegerPart, c-'0')
 		}
 	}
-	return appendAffix(dst, f, suffix, neg), savedLen, len(dst)
+	return appendAffix(dst, f, suffix, neg, suffixSpacing), savedLen, len(dst)
 }
 
 const (
@@ -485,21 +535,34 @@ func (f *Formatter) renderSpecial(dst []byte, d *Digits) (b []byte, ok bool) {
 }
 
 func fmtNaN(dst []byte, f *Formatter) []byte {
-	return append(dst, f.Symbol(SymNan)...)
+	return f.appendPart(dst, NaNPart, f.Symbol(SymNan))
 }
 
 func fmtInfinite(dst []byte, f *Formatter, d *Digits) []byte {
 	affix, suffix := f.getAffixes(d.Neg)
-	dst = appendAffix(dst, f, affix, d.Neg)
-	dst = append(dst, f.Symbol(SymInfinity)...)
-	dst = appendAffix(dst, f, suffix, d.Neg)
+	dst = appendAffix(dst, f, affix, d.Neg, noSpacing)
+	dst = f.appendPart(dst, InfinityPart, f.Symbol(SymInfinity))
+	dst = appendAffix(dst, f, suffix, d.Neg, noSpacing)
 	return dst
 }
 
-func appendAffix(dst []byte, f *Formatter, affix string, neg bool) []byte {
+// Positions of an affix for the purpose of currency spacing.
+const (
+	noSpacing     = iota // the affix is not adjacent to digits
+	prefixSpacing        // the affix precedes the digits
+	suffixSpacing        // the affix follows the digits
+)
+
+// currencySpace is inserted between a currency symbol and the digits of a
+// number according to the CLDR currency spacing rules.
+const currencySpace = "\u00a0"
+
+func appendAffix(dst []byte, f *Formatter, affix string, neg bool, pos int) []byte {
 	quoting := false
 	escaping := false
-	for _, r := range affix {
+	for i, r := range affix {
+		start := len(dst)
+		kind := LiteralPart
 		switch {
 		case escaping:
 			// escaping occurs both inside and outside of quotes
@@ -511,16 +574,42 @@ func appendAffix(dst []byte, f *Formatter, affix string, neg bool) []byte {
 			quoting = !quoting
 		case quoting:
 			dst = append(dst, string(r)...)
+		case r == '¤' && f.Currency != "":
+			if strings.HasSuffix(affix[:i], "¤") {
+				// ¤¤ and ¤¤¤ select the form of the symbol, which is
+				// already reflected in Currency.
+				continue
+			}
+			n := i + len("¤")
+			for strings.HasPrefix(affix[n:], "¤") {
+				n += len("¤")
+			}
+			if pos == suffixSpacing && i == 0 && needsCurrencySpace(f.Currency, false) {
+				dst = f.appendPart(dst, LiteralPart, currencySpace)
+				start = len(dst)
+			}
+			kind = CurrencyPart
+			dst = append(dst, f.Currency...)
+			if pos == prefixSpacing && n == len(affix) && needsCurrencySpace(f.Currency, true) {
+				f.mark(kind, start, dst)
+				start = len(dst)
+				kind = LiteralPart
+				dst = append(dst, currencySpace...)
+			}
 		case r == '%':
 			if f.DigitShift == 3 {
+				kind = PerMilleSignPart
 				dst = append(dst, f.Symbol(SymPerMille)...)
 			} else {
+				kind = PercentSignPart
 				dst = append(dst, f.Symbol(SymPercentSign)...)
 			}
 		case r == '-' || r == '+':
 			if neg {
+				kind = MinusSignPart
 				dst = append(dst, f.Symbol(SymMinusSign)...)
 			} else if f.Flags&ElideSign == 0 {
+				kind = PlusSignPart
 				dst = append(dst, f.Symbol(SymPlusSign)...)
 			} else {
 				dst = append(dst, ' ')
@@ -528,6 +617,22 @@ func appendAffix(dst []byte, f *Formatter, affix string, neg bool) []byte {
 		default:
 			dst = append(dst, string(r)...)
 		}
+		f.mark(kind, start, dst)
 	}
 	return dst
 }
+
+// needsCurrencySpace reports whether a space needs to be inserted between the
+// currency symbol and the adjacent digits of a number. This is the case if the
+// rune of the symbol closest to the number is neither a symbol nor a
+// separator, which corresponds to the CLDR root currencySpacing pattern
+// [[:^S:]&[:^Z:]]. The symbol precedes the number if before is true.
+func needsCurrencySpace(symbol string, before bool) bool {
+	var r rune
+	if before {
+		r, _ = utf8.DecodeLastRuneInString(symbol)
+	} else {
+		r, _ = utf8.DecodeRuneInString(symbol)
+	}
+	return !unicode.In(r, unicode.S, unicode.Z)
+}
@@ -520,3 +520,112 @@ func TestFormatters(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatDigitsRounding(t *testing.T) {
+	testCases := []struct {
+		num     string
+		maxFrac int
+		want    string
+	}{
+		{"999.999", 0, "1000"},
+		{"999.999", 2, "1000.00"},
+		{"9.96", 1, "10.0"},
+		{"1.25", 1, "1.2"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.num, func(t *testing.T) {
+			var f Formatter
+			f.InitDecimal(language.English)
+			f.GroupingSize = [2]uint8{}
+			var d Decimal
+			d.Convert(RoundingContext{MaxFractionDigits: -1}, dec(tc.num))
+
+			rc := f.RoundingContext
+			rc.MaxFractionDigits = int16(tc.maxFrac)
+			rc.MinFractionDigits = uint8(tc.maxFrac)
+			if got := string(f.Render(nil, FormatDigits(&d, rc))); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParts(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		width   int
+		num     string
+		want    string
+	}{
+		{"#,##0.00", 0, "-1234.5", "MinusSignPart:- IntegerPart:1 GroupPart:, IntegerPart:234 DecimalPart:. FractionPart:50"},
+		{"* #0 o''clock", 12, "4", "LiteralPart:    IntegerPart:4 LiteralPart: o'clock"},
+		{"'$'* #0;('$'* #0)", 6, "-4", "LiteralPart:($ LiteralPart:   IntegerPart:4 LiteralPart:)"},
+		{"0.0E+00", 0, "-0.01234", "MinusSignPart:- IntegerPart:1 DecimalPart:. FractionPart:2 ExponentSeparatorPart:×10 ExponentMinusSignPart:⁻ ExponentIntegerPart:⁰²"},
+		{"#0%", 0, "0.25", "IntegerPart:25 PercentSignPart:%"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			p, err := ParsePattern(tc.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var parts []Part
+			var f Formatter
+			f.InitPattern(language.English, p)
+			if tc.width > 0 {
+				f.FormatWidth = uint16(tc.width)
+			}
+			f.Parts = &parts
+			b := f.Append([]byte("x"), dec(tc.num))
+			got := ""
+			end := 1
+			for _, p := range parts {
+				if p.Start != end {
+					t.Errorf("part %v starts at %d; want %d", p.Kind, p.Start, end)
+				}
+				end = p.End
+				got += fmt.Sprintf(" %v:%s", p.Kind, b[p.Start:p.End])
+			}
+			if end != len(b) {
+				t.Errorf("parts end at %d; want %d", end, len(b))
+			}
+			if got = got[1:]; got != tc.want {
+				t.Errorf("got %q (%q);\nwant %q", got, b, tc.want)
+			}
+		})
+	}
+}
+
+func TestCurrency(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		symbol  string
+		num     string
+		want    string
+	}{
+		{"¤#,##0.00", "$", "-1234.5", "-$1,234.50"},
+		{"¤#,##0.00", "USD", "1234.5", "USD\u00a01,234.50"},
+		{"¤¤#,##0.00", "USD", "1234.5", "USD\u00a01,234.50"},
+		{"#,##0.00¤", "€", "1", "1.00€"},
+		{"#,##0.00¤", "EUR", "1", "1.00\u00a0EUR"},
+		{"#,##0.00\u00a0¤", "EUR", "1", "1.00\u00a0EUR"},
+		{"¤ #,##0.00;¤-#,##0.00", "CHF", "-1", "CHF-1.00"},
+		{"¤#,##0.00;(¤#,##0.00)", "USD", "-1", "(USD\u00a01.00)"},
+		{"'¤'#,##0.00", "USD", "1", "¤1.00"},
+		{"¤#,##0.00", "", "1", "¤1.00"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern+"/"+tc.symbol, func(t *testing.T) {
+			p, err := ParsePattern(tc.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var f Formatter
+			f.InitPattern(language.English, p)
+			f.Currency = tc.symbol
+			if got := string(f.Append(nil, dec(tc.num))); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -56,32 +56,35 @@ func InfoFromLangID(compactIndex compact.ID, numberSystem string) Info {
 				// The index points to a list of symbol data indexes.
 				for _, e := range langToAlt[p&^hasNonLatnMask:] {
 					if e.compactTag != langIndex {
-						if langIndex == 0 {
-							// The CLDR root defines full symbol information for
-							// all numbering systems (even though mostly by
-							// means of aliases). Fall back to the default entry
-							// for Latn if there is no data for the numbering
-							// system of this language.
-							if ns == 0 {
-								break
-							}
-							// Fall back to Latin and start from the original
-							// language. See
-							// https://unicode.org/reports/tr35/#Locale_Inheritance.
-							ns = numLatn
-							langIndex = compactIndex
-							continue outerLoop
-						}
-						// Fall back to parent.
-						langIndex = langIndex.Parent()
-					} else if e.system == ns {
+						break
+					}
+					if e.system == ns {
 						pSymIndex = e.symIndex
 						break outerLoop
 					}
 				}
+				if langIndex == 0 {
+					// The CLDR root defines full symbol information for all
+					// numbering systems (even though mostly by means of
+					// aliases). Fall back to Latin and start from the original
+					// language if there is no data for the numbering system of
+					// this language. See
+					// https://unicode.org/reports/tr35/#Locale_Inheritance.
+					ns = numLatn
+					langIndex = compactIndex
+					continue
+				}
+				// Fall back to parent.
+				langIndex = langIndex.Parent()
 			}
 		}
 	}
+	if system == numHanidec {
+		return Info{
+			system:   hanidecData,
+			symIndex: pSymIndex,
+		}
+	}
 	if int(system) >= len(numSysData) { // algorithmic
 		// Will generate ASCII digits in case the user inadvertently calls
 		// WriteDigit or Digit on it.
@@ -98,6 +101,14 @@ func InfoFromLangID(compactIndex compact.ID, numberSystem string) Info {
 	}
 }
 
+// The digits of the hanidec numbering system are not encoded consecutively.
+// The system is handled separately from the other decimal systems.
+var (
+	hanidecDigits = [10]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+	hanidecData = systemData{id: numHanidec, digitSize: 3, zero: [utf8.UTFMax]byte{0xe3, 0x80, 0x87}}
+)
+
 // InfoFromTag returns a Info for the given language tag.
 func InfoFromTag(t language.Tag) Info {
 	return InfoFromLangID(tagToID(t), t.TypeForKey("nu"))
@@ -106,13 +117,27 @@ func InfoFromTag(t language.Tag) Info {
 // IsDecimal reports if the numbering system can convert decimal to native
 // symbols one-to-one.
 func (n Info) IsDecimal() bool {
-	return int(n.system.id) < len(numSysData)
+	return int(n.system.id) < len(numSysData) || n.system.id == numHanidec
+}
+
+// NumberingSystem returns the CLDR identifier of the numbering system, such as
+// "latn" or "hanidec".
+func (n Info) NumberingSystem() string {
+	for name, id := range systemMap {
+		if id == n.system.id {
+			return name
+		}
+	}
+	return "latn"
 }
 
 // WriteDigit writes the UTF-8 sequence for n corresponding to the given ASCII
 // digit to dst and reports the number of bytes written. dst must be large
 // enough to hold the rune (can be up to utf8.UTFMax bytes).
 func (n Info) WriteDigit(dst []byte, asciiDigit rune) int {
+	if n.system.id == numHanidec {
+		return copy(dst, hanidecDigits[asciiDigit-'0'])
+	}
 	copy(dst, n.system.zero[:n.system.digitSize])
 	dst[n.system.digitSize-1] += byte(asciiDigit - '0')
 	return int(n.system.digitSize)
@@ -122,6 +147,9 @@ func (n Info) WriteDigit(dst []byte, asciiDigit rune) int {
 // to dst and reports the number of bytes written. dst must be large enough to
 // hold the rune (can be up to utf8.UTFMax bytes).
 func (n Info) AppendDigit(dst []byte, digit byte) []byte {
+	if n.system.id == numHanidec {
+		return append(dst, hanidecDigits[digit]...)
+	}
 	dst = append(dst, n.system.zero[:n.system.digitSize]...)
 	dst[len(dst)-1] += digit
 	return dst
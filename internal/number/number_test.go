@@ -50,9 +50,14 @@ func TestInfo(t *testing.T) {
 		{"dz-u-nu-tibt", SymInfinity, "\u0f42\u0fb2\u0f44\u0f66\u0f0b\u0f58\u0f7a\u0f51", '\u0f29'},
 		{"en-u-nu-tibt", SymInfinity, "∞", '\u0f29'},
 
+		// U+4E5D CJK UNIFIED IDEOGRAPH-4E5D (九); hanidec digits are not
+		// consecutive.
+		{"en-u-nu-hanidec", SymPlusSign, "+", '\u4e5d'},
+		{"zh-TW-u-nu-hanidec", SymGroup, ",", '\u4e5d'}, // miss in zh -> und
+
 		// algorithmic number systems fall back to ASCII if Digits is used.
-		{"en-u-nu-hanidec", SymPlusSign, "+", '9'},
 		{"en-u-nu-roman", SymPlusSign, "+", '9'},
+		{"en-u-nu-hant", SymPlusSign, "+", '9'},
 	}
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("%s:%v", tc.lang, tc.sym), func(t *testing.T) {
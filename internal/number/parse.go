@@ -0,0 +1,516 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// A Parser parses numbers formatted according to the conventions of a
+// language. It accepts numbers formatted with the decimal, percent, and
+// permille patterns of the language.
+//
+// By default, a Parser only accepts numbers in the form produced by a
+// Formatter for the same language: digits of the numbering system of the
+// language, grouping separators, if any, at the positions defined by the
+// pattern, and signs and percent symbols as they appear in the affixes of the
+// pattern.
+//
+// In lenient mode, a Parser ignores surrounding white space and bidi control
+// characters, accepts ASCII digits and digits of any other decimal numbering
+// system, grouping separators at any position within the integer part,
+// common variants of the grouping, sign, and percent symbols, signs and
+// percent symbols before or after the number, and an exponent. Lenient
+// parsing does not accept a decimal separator other than that of the
+// language, as this could lead to misinterpreting grouping separators.
+type Parser struct {
+	Info
+
+	// Lenient selects lenient instead of strict parsing.
+	Lenient bool
+
+	// Currency allows a currency symbol or code before or after the number.
+	Currency bool
+
+	patterns [3]Pattern // decimal, percent, and permille
+	affixes  []parseAffix
+}
+
+// A parseAffix is a prefix and suffix as rendered by one of the patterns of a
+// Parser.
+type parseAffix struct {
+	prefix, suffix string
+	neg            bool
+	pattern        uint8 // index into patterns
+}
+
+var (
+	errSyntax = errors.New("number: invalid number syntax")
+	errRange  = errors.New("number: exponent out of range")
+)
+
+// maxParseExp bounds the exponent of a parsed number, as the resulting decimal
+// is written out in full.
+const maxParseExp = 10000
+
+// Init initializes a Parser for the given language.
+func (p *Parser) Init(t language.Tag) {
+	var f Formatter
+	f.InitDecimal(t)
+	p.Info = f.Info
+	p.patterns[0] = f.Pattern
+	f.InitPercent(t)
+	p.patterns[1] = f.Pattern
+	f.InitPerMille(t)
+	p.patterns[2] = f.Pattern
+
+	p.affixes = p.affixes[:0]
+	for i := range p.patterns {
+		f := Formatter{Pattern: p.patterns[i], Info: p.Info}
+		for _, neg := range []bool{false, true} {
+			p.addAffix(&f, neg, uint8(i))
+		}
+		if f.NegOffset == 0 {
+			f.Flags |= AlwaysSign
+			p.addAffix(&f, false, uint8(i))
+		}
+	}
+}
+
+func (p *Parser) addAffix(f *Formatter, neg bool, pattern uint8) {
+	prefix, suffix := f.getAffixes(neg)
+	p.affixes = append(p.affixes, parseAffix{
+		prefix:  string(appendAffix(nil, f, prefix, neg, noSpacing)),
+		suffix:  string(appendAffix(nil, f, suffix, neg, noSpacing)),
+		neg:     neg,
+		pattern: pattern,
+	})
+}
+
+// Parse parses s and returns the number it represents as a decimal string in
+// the syntax accepted by strconv.ParseFloat, such as "-1234.50", "Inf", or
+// "NaN". The decimal is exact: percentages and exponents are applied by
+// moving the decimal separator and trailing fraction zeros are retained. If
+// p.Currency is set, Parse also returns the currency symbol or code found
+// before or after the number.
+func (p *Parser) Parse(s string) (num, currency string, err error) {
+	if p.Lenient {
+		s = strings.TrimFunc(removeBidi(s), unicode.IsSpace)
+	}
+	if p.isNaN(s) {
+		return "NaN", "", nil
+	}
+	var n scanned
+	start, end := p.scan(&n, s)
+	if start < 0 {
+		return "", "", errSyntax
+	}
+	prefix, suffix := s[:start], s[end:]
+	if p.Currency {
+		prefix, suffix, currency = cutCurrency(prefix, suffix)
+	}
+	var neg bool
+	var shift int
+	if p.Lenient {
+		neg, shift, err = p.matchLenient(prefix + " " + suffix)
+	} else {
+		neg, shift, err = p.matchStrict(&n, prefix, suffix)
+	}
+	if err != nil {
+		return "", "", err
+	}
+	num, err = n.decimal(neg, int(n.exp)-shift)
+	return num, currency, err
+}
+
+func (p *Parser) isNaN(s string) bool {
+	nan := p.Symbol(SymNan)
+	if p.Lenient {
+		return strings.EqualFold(s, removeBidi(nan)) || strings.EqualFold(s, "nan")
+	}
+	return s == nan
+}
+
+// scanned holds the parts of a scanned number.
+type scanned struct {
+	intDigits  []byte // ASCII digits
+	fracDigits []byte
+	groups     []int // number of integer digits before each grouping separator
+	hasDecimal bool
+	exp        int
+	inf        bool
+}
+
+// scan scans the first number in s into n and returns its start and end
+// position. It returns -1 for start if s does not contain a number.
+func (p *Parser) scan(n *scanned, s string) (start, end int) {
+	sys := &p.system
+	if p.Lenient {
+		sys = nil
+	}
+	inf := p.Symbol(SymInfinity)
+	decimal := p.Symbol(SymDecimal)
+	for start = 0; start < len(s); {
+		if strings.HasPrefix(s[start:], inf) {
+			n.inf = true
+			return start, start + len(inf)
+		}
+		if _, _, ok := matchDigit(s[start:], &sys); ok {
+			break
+		}
+		if p.Lenient && strings.HasPrefix(s[start:], decimal) {
+			if _, _, ok := matchDigit(s[start+len(decimal):], &sys); ok {
+				break
+			}
+		}
+		_, size := utf8.DecodeRuneInString(s[start:])
+		start += size
+	}
+	if start == len(s) {
+		return -1, 0
+	}
+
+	i := start
+	for i < len(s) {
+		if d, size, ok := matchDigit(s[i:], &sys); ok {
+			n.intDigits = append(n.intDigits, '0'+d)
+			i += size
+			continue
+		}
+		size := p.matchGroup(s[i:])
+		if size == 0 || len(n.intDigits) == 0 {
+			break
+		}
+		if _, _, ok := matchDigit(s[i+size:], &sys); !ok {
+			break
+		}
+		n.groups = append(n.groups, len(n.intDigits))
+		i += size
+	}
+	if strings.HasPrefix(s[i:], decimal) {
+		n.hasDecimal = true
+		i += len(decimal)
+		for i < len(s) {
+			d, size, ok := matchDigit(s[i:], &sys)
+			if !ok {
+				break
+			}
+			n.fracDigits = append(n.fracDigits, '0'+d)
+			i += size
+		}
+	}
+	return start, p.scanExponent(n, s, i, sys)
+}
+
+// scanExponent scans an optional exponent starting at position i of s and
+// returns the end of the number. Exponents are only accepted in lenient mode.
+func (p *Parser) scanExponent(n *scanned, s string, i int, sys *systemData) int {
+	if !p.Lenient {
+		return i
+	}
+	j := i
+	switch e := p.Symbol(SymExponential); {
+	case strings.HasPrefix(s[j:], e):
+		j += len(e)
+	case j < len(s) && (s[j] == 'e' || s[j] == 'E'):
+		j++
+	default:
+		return i
+	}
+	neg := false
+	if size, minus := p.matchSign(s[j:]); size > 0 {
+		j += size
+		neg = minus
+	}
+	exp, digits := 0, 0
+	for j < len(s) {
+		d, size, ok := matchDigit(s[j:], &sys)
+		if !ok {
+			break
+		}
+		if exp < maxParseExp {
+			exp = 10*exp + int(d)
+		}
+		digits++
+		j += size
+	}
+	if digits == 0 {
+		return i
+	}
+	if neg {
+		exp = -exp
+	}
+	n.exp = exp
+	return j
+}
+
+// matchDigit reports the value and size of the digit at the start of s. If
+// *sys is nil, digits of any decimal numbering system are accepted and *sys
+// is set to the numbering system of the digit found.
+func matchDigit(s string, sys **systemData) (d byte, size int, ok bool) {
+	if *sys != nil {
+		return (*sys).matchDigit(s)
+	}
+	for i := range numSysData {
+		if d, size, ok = numSysData[i].matchDigit(s); ok {
+			*sys = &numSysData[i]
+			return d, size, ok
+		}
+	}
+	return 0, 0, false
+}
+
+func (sys *systemData) matchDigit(s string) (d byte, size int, ok bool) {
+	if sys.id == numHanidec {
+		for i, h := range hanidecDigits {
+			if strings.HasPrefix(s, h) {
+				return byte(i), len(h), true
+			}
+		}
+		return 0, 0, false
+	}
+	n := int(sys.digitSize)
+	if len(s) < n || s[:n-1] != string(sys.zero[:n-1]) {
+		return 0, 0, false
+	}
+	if d = s[n-1] - sys.zero[n-1]; d > 9 {
+		return 0, 0, false
+	}
+	return d, n, true
+}
+
+// matchGroup returns the size of the grouping separator at the start of s or
+// 0 if there is none.
+func (p *Parser) matchGroup(s string) int {
+	group := p.Symbol(SymGroup)
+	if strings.HasPrefix(s, group) {
+		return len(group)
+	}
+	if !p.Lenient {
+		return 0
+	}
+	g, _ := utf8.DecodeRuneInString(group)
+	r, size := utf8.DecodeRuneInString(s)
+	switch {
+	case unicode.IsSpace(g) && unicode.IsSpace(r):
+	case isApostrophe(g) && isApostrophe(r):
+	default:
+		return 0
+	}
+	return size
+}
+
+// matchSign returns the size of the plus or minus sign at the start of s, or 0
+// if there is none, and reports whether the sign is a minus sign.
+func (p *Parser) matchSign(s string) (size int, minus bool) {
+	for _, x := range []struct {
+		sym   SymbolType
+		minus bool
+	}{{SymMinusSign, true}, {SymPlusSign, false}} {
+		sym := p.Symbol(x.sym)
+		if p.Lenient {
+			sym = removeBidi(sym)
+		}
+		if strings.HasPrefix(s, sym) {
+			return len(sym), x.minus
+		}
+	}
+	if !p.Lenient {
+		return 0, false
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	switch {
+	case strings.ContainsRune("-\u2212\u2010\u2011\u2012\u2013\ufe63\uff0d", r):
+		return size, true
+	case strings.ContainsRune("+\uff0b", r):
+		return size, false
+	}
+	return 0, false
+}
+
+// matchStrict matches the prefix and suffix of a number against the affixes
+// of the patterns and reports the sign and the number of decimals by which
+// the number is shifted.
+func (p *Parser) matchStrict(n *scanned, prefix, suffix string) (neg bool, shift int, err error) {
+	if len(n.intDigits) == 0 && !n.inf {
+		return false, 0, errSyntax
+	}
+	for _, a := range p.affixes {
+		if a.prefix != prefix || a.suffix != suffix {
+			continue
+		}
+		pat := &p.patterns[a.pattern]
+		if n.hasDecimal && len(n.fracDigits) == 0 && pat.Flags&AlwaysDecimalSeparator == 0 {
+			return false, 0, errSyntax
+		}
+		if !n.validGroups(pat) {
+			return false, 0, errSyntax
+		}
+		return a.neg, int(pat.DigitShift), nil
+	}
+	return false, 0, errSyntax
+}
+
+// validGroups reports whether the grouping separators of n, if any, are at
+// the positions defined by pat.
+func (n *scanned) validGroups(pat *Pattern) bool {
+	if len(n.groups) == 0 {
+		return true
+	}
+	numInt := len(n.intDigits)
+	g := n.groups
+	for i := 1; i < numInt; i++ {
+		hasSep := len(g) > 0 && g[0] == i
+		if hasSep {
+			g = g[1:]
+		}
+		if hasSep != pat.needsSep(numInt-i+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchLenient interprets the text surrounding a number, which may consist
+// of at most one sign and one percent or permille symbol, and reports the
+// sign and the number of decimals by which the number is shifted.
+func (p *Parser) matchLenient(s string) (neg bool, shift int, err error) {
+	hasSign := false
+	percent := removeBidi(p.Symbol(SymPercentSign))
+	permille := removeBidi(p.Symbol(SymPerMille))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			i += size
+			continue
+		}
+		if size, minus := p.matchSign(s[i:]); size > 0 && !hasSign {
+			hasSign, neg = true, minus
+			i += size
+			continue
+		}
+		if shift == 0 {
+			switch {
+			case strings.HasPrefix(s[i:], percent):
+				shift, size = 2, len(percent)
+			case strings.HasPrefix(s[i:], permille):
+				shift, size = 3, len(permille)
+			case strings.ContainsRune("%\u066a\ufe6a\uff05", r):
+				shift = 2
+			case strings.ContainsRune("\u2030\u0609", r):
+				shift = 3
+			}
+			if shift != 0 {
+				i += size
+				continue
+			}
+		}
+		return false, 0, errSyntax
+	}
+	return neg, shift, nil
+}
+
+// decimal returns n as a decimal string with the decimal separator moved
+// shift positions to the right.
+func (n *scanned) decimal(neg bool, shift int) (string, error) {
+	if shift > maxParseExp || shift < -maxParseExp {
+		return "", errRange
+	}
+	var buf []byte
+	if neg {
+		buf = append(buf, '-')
+	}
+	if n.inf {
+		return string(append(buf, "Inf"...)), nil
+	}
+	digits := append(n.intDigits, n.fracDigits...)
+	pos := len(n.intDigits) + shift
+	for ; pos < 0; pos++ {
+		digits = append([]byte{'0'}, digits...)
+	}
+	for len(digits) < pos {
+		digits = append(digits, '0')
+	}
+	intDigits := strings.TrimLeft(string(digits[:pos]), "0")
+	if intDigits == "" {
+		intDigits = "0"
+	}
+	buf = append(buf, intDigits...)
+	if pos < len(digits) {
+		buf = append(buf, '.')
+		buf = append(buf, digits[pos:]...)
+	}
+	return string(buf), nil
+}
+
+// cutCurrency removes the first currency symbol or code from the prefix or,
+// if there is none, from the suffix of a number, together with a space
+// separating it from the number.
+func cutCurrency(prefix, suffix string) (newPrefix, newSuffix, currency string) {
+	if start, end := currencySpan(prefix); start < end {
+		if r, size := utf8.DecodeRuneInString(prefix[end:]); unicode.IsSpace(r) {
+			end += size
+		}
+		return prefix[:start] + prefix[end:], suffix, strings.TrimRightFunc(prefix[start:end], unicode.IsSpace)
+	}
+	if start, end := currencySpan(suffix); start < end {
+		if r, size := utf8.DecodeLastRuneInString(suffix[:start]); unicode.IsSpace(r) {
+			start -= size
+		}
+		return prefix, suffix[:start] + suffix[end:], strings.TrimLeftFunc(suffix[start:end], unicode.IsSpace)
+	}
+	return prefix, suffix, ""
+}
+
+// currencySpan returns the position of the first run of runes in s that may
+// be part of a currency symbol or code.
+func currencySpan(s string) (start, end int) {
+	start = strings.IndexFunc(s, isCurrencyRune)
+	if start < 0 {
+		return 0, 0
+	}
+	end = strings.IndexFunc(s[start:], func(r rune) bool { return !isCurrencyRune(r) })
+	if end < 0 {
+		return start, len(s)
+	}
+	return start, start + end
+}
+
+func isCurrencyRune(r rune) bool {
+	switch {
+	case unicode.IsSpace(r), unicode.IsDigit(r), isBidiControl(r):
+		return false
+	case strings.ContainsRune("-+\u2212%\u066a\u2030\u0609()", r):
+		return false
+	}
+	return true
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '\u2019' || r == '\u02bc'
+}
+
+// removeBidi removes bidi control characters, such as the left-to-right and
+// Arabic letter marks, from s.
+func removeBidi(s string) string {
+	if strings.IndexFunc(s, isBidiControl) < 0 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if isBidiControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isBidiControl(r rune) bool {
+	return unicode.Is(unicode.Bidi_Control, r)
+}
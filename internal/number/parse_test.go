@@ -0,0 +1,196 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		tag     string
+		lenient bool
+		in      string
+		want    string // "err" for an error
+	}{
+		{"en", false, "0", "0"},
+		{"en", false, "1,234.56", "1234.56"},
+		{"en", false, "1234.56", "1234.56"},
+		{"en", false, "1.50", "1.50"},
+		{"en", false, "-1,234", "-1234"},
+		{"en", false, "+5", "5"},
+		{"en", false, "12%", "0.12"},
+		{"en", false, "12.5%", "0.125"},
+		{"en", false, "-3‰", "-0.003"},
+		{"en", false, "∞", "Inf"},
+		{"en", false, "-∞", "-Inf"},
+		{"en", false, "NaN", "NaN"},
+		{"en", false, "12,34.5", "err"},
+		{"en", false, "1,2345", "err"},
+		{"en", false, " 12", "err"},
+		{"en", false, "1.", "err"},
+		{"en", false, ".5", "err"},
+		{"en", false, "1e3", "err"},
+		{"en", false, "5-", "err"},
+		{"en", false, "", "err"},
+		{"en", false, "-", "err"},
+		{"en", false, "1,234.5.6", "err"},
+		{"en", false, "١٢", "err"},
+
+		{"de", false, "1.234,56", "1234.56"},
+		{"de", false, "1234,5", "1234.5"},
+		{"de", false, "1,234.56", "err"},
+		{"de", false, "12\u00a0%", "0.12"},
+		{"de", false, "12 %", "err"},
+		{"de", true, "12 %", "0.12"},
+		{"de-CH", false, "1’234.5", "1234.5"},
+		{"de-CH", false, "1'234.5", "err"},
+		{"de-CH", true, "1'234.5", "1234.5"},
+
+		{"fr", false, "1\u00a0234,5", "1234.5"},
+		{"fr", false, "1 234,5", "err"},
+		{"fr", false, "1\u202f234,5", "err"},
+		{"fr", true, "1 234,5", "1234.5"},
+		{"fr", true, "1\u202f234,5", "1234.5"},
+		{"fr", true, "1.5", "err"},
+
+		{"hi", false, "12,34,567", "1234567"},
+		{"hi", false, "1,234,567", "err"},
+		{"hi", true, "1,234,567", "1234567"},
+
+		{"ar", false, "١٢٣", "123"},
+		{"ar", false, "١٬٢٣٤٫٥", "1234.5"},
+		{"ar", false, "؜-١٢٣", "-123"},
+		{"ar", false, "123", "err"},
+		{"ar", true, "123", "123"},
+		{"ar", true, "-١٢٣", "-123"},
+		{"fa", false, "۱۲۳", "123"},
+		{"en-u-nu-arab", false, "١٢٣", "123"},
+		{"en-u-nu-arab", false, "123", "err"},
+
+		{"en", true, " -1,234.5 ", "-1234.5"},
+		{"en", true, "‎-5", "-5"},
+		{"en", true, "1.5e3", "1500"},
+		{"en", true, "1.5E-3", "0.0015"},
+		{"en", true, "1e", "err"},
+		{"en", true, "1e99999", "err"},
+		{"en", true, "5-", "-5"},
+		{"en", true, "−7", "-7"},
+		{"en", true, "--7", "err"},
+		{"en", true, "12 %", "0.12"},
+		{"en", true, "% 12", "0.12"},
+		{"en", true, "2‰", "0.002"},
+		{"en", true, "5%%", "err"},
+		{"en", true, "1,2,3", "123"},
+		{"en", true, "1,,2", "err"},
+		{"en", true, "1.2.3", "err"},
+		{"en", true, "1 234", "err"},
+		{"en", true, ".5", "0.5"},
+		{"en", true, "1.", "1"},
+		{"en", true, "007", "7"},
+		{"en", true, "٣", "3"},
+		{"en", true, "１２", "12"},
+		{"en", true, "1٣", "err"},
+		{"en", true, "nan", "NaN"},
+		{"en", true, "abc", "err"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s/%v/%s", tc.tag, tc.lenient, tc.in), func(t *testing.T) {
+			var p Parser
+			p.Init(language.MustParse(tc.tag))
+			p.Lenient = tc.lenient
+			got, _, err := p.Parse(tc.in)
+			if err != nil {
+				got = "err"
+			}
+			if got != tc.want {
+				t.Errorf("got %q (%v); want %q", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	testCases := []struct {
+		tag      string
+		lenient  bool
+		in       string
+		want     string
+		currency string
+	}{
+		{"en", false, "$ 1,234.50", "1234.50", "$"},
+		{"en", false, "-$ 5", "-5", "$"},
+		{"en", false, "USD 5", "5", "USD"},
+		{"en", false, "US$5", "5", "US$"},
+		{"en", false, "5", "5", ""},
+		{"en", false, "$", "err", ""},
+		{"en", false, "$ 5 $", "err", ""},
+		{"de", false, "5,00 €", "5.00", "€"},
+		{"de", false, "€ 5,00", "5.00", "€"},
+		{"fr", true, " 12,50\u00a0€ ", "12.50", "€"},
+		{"en", true, "$-5", "-5", "$"},
+		{"en", true, "CHF 1'000", "err", ""},
+		{"de-CH", true, "CHF 1'000.50", "1000.50", "CHF"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s/%v/%s", tc.tag, tc.lenient, tc.in), func(t *testing.T) {
+			var p Parser
+			p.Init(language.MustParse(tc.tag))
+			p.Lenient = tc.lenient
+			p.Currency = true
+			got, currency, err := p.Parse(tc.in)
+			if err != nil {
+				got = "err"
+			}
+			if got != tc.want || currency != tc.currency {
+				t.Errorf("got %q, %q (%v); want %q, %q", got, currency, err, tc.want, tc.currency)
+			}
+		})
+	}
+}
+
+// TestParseFormatted tests that numbers formatted by a Formatter are parsed
+// strictly to their original value.
+func TestParseFormatted(t *testing.T) {
+	tags := []string{"en", "de", "de-CH", "fr", "es", "hi", "en-IN", "ar", "fa", "bn", "ja", "ru", "sv", "en-u-nu-deva"}
+	nums := []string{"0", "-1", "12.5", "1234.5678", "-1234567.25", "0.001"}
+	for _, tag := range tags {
+		lang := language.MustParse(tag)
+		var p Parser
+		p.Init(lang)
+		for _, num := range nums {
+			for _, init := range []struct {
+				name string
+				init func(f *Formatter, t language.Tag)
+			}{
+				{"decimal", (*Formatter).InitDecimal},
+				{"percent", (*Formatter).InitPercent},
+				{"permille", (*Formatter).InitPerMille},
+			} {
+				var f Formatter
+				init.init(&f, lang)
+				f.RoundingContext.MaxFractionDigits = -1
+				var d Decimal
+				d.Convert(f.RoundingContext, dec(num))
+				s := string(f.Format(nil, &d))
+				got, _, err := p.Parse(s)
+				if err != nil {
+					t.Errorf("%s/%s/%s: Parse(%q): %v", tag, init.name, num, s, err)
+					continue
+				}
+				var want Decimal
+				want.Convert(RoundingContext{MaxFractionDigits: -1}, dec(num))
+				var back Decimal
+				back.Convert(RoundingContext{MaxFractionDigits: -1}, dec(got))
+				if back.String() != want.String() && !(len(back.Digits) == 0 && len(want.Digits) == 0) {
+					t.Errorf("%s/%s/%s: Parse(%q) = %q", tag, init.name, num, s, got)
+				}
+			}
+		}
+	}
+}
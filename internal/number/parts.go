@@ -0,0 +1,88 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+//go:generate stringer -type PartKind
+
+// A PartKind identifies the kind of a part of a formatted number.
+type PartKind uint8
+
+const (
+	LiteralPart           PartKind = iota // text of a pattern, such as a space
+	IntegerPart                           // integer digits between group separators
+	GroupPart                             // group separator
+	DecimalPart                           // decimal separator
+	FractionPart                          // fraction digits
+	MinusSignPart                         // minus sign
+	PlusSignPart                          // plus sign
+	ExponentSeparatorPart                 // exponent symbol, such as "E" or "×10"
+	ExponentMinusSignPart                 // minus sign of the exponent
+	ExponentPlusSignPart                  // plus sign of the exponent
+	ExponentIntegerPart                   // exponent digits
+	PercentSignPart                       // percent sign
+	PerMilleSignPart                      // per mille sign
+	CurrencyPart                          // currency symbol, code, or name
+	InfinityPart                          // infinity symbol
+	NaNPart                               // not-a-number symbol
+	CompactPart                           // abbreviation of a compact number, such as "K"
+)
+
+// A Part is a part of a formatted number. Start and End are the byte offsets
+// of the part in the formatted number.
+type Part struct {
+	Kind       PartKind
+	Start, End int
+}
+
+// mark records the part of kind k from start to the end of dst if f records
+// parts. Adjacent parts of the same kind are merged.
+func (f *Formatter) mark(k PartKind, start int, dst []byte) {
+	if f.Parts == nil || start >= len(dst) {
+		return
+	}
+	p := *f.Parts
+	if n := len(p); n > 0 && p[n-1].Kind == k && p[n-1].End == start {
+		p[n-1].End = len(dst)
+	} else {
+		p = append(p, Part{k, start, len(dst)})
+	}
+	*f.Parts = p
+}
+
+// insertPart records the insertion of a part of kind k of n bytes at position
+// pos, shifting the parts that follow pos.
+func (f *Formatter) insertPart(k PartKind, pos, n int) {
+	if f.Parts == nil || n == 0 {
+		return
+	}
+	p := *f.Parts
+	i := len(p)
+	for i > 0 && p[i-1].Start >= pos {
+		i--
+		p[i].Start += n
+		p[i].End += n
+	}
+	p = append(p, Part{})
+	copy(p[i+1:], p[i:])
+	p[i] = Part{k, pos, pos + n}
+	*f.Parts = p
+}
+
+// appendPart appends s to dst and records it as a part of kind k.
+func (f *Formatter) appendPart(dst []byte, k PartKind, s string) []byte {
+	start := len(dst)
+	dst = append(dst, s...)
+	f.mark(k, start, dst)
+	return dst
+}
+
+// appendDigitPart appends the localized digit d to dst and records it as a
+// part of kind k.
+func (f *Formatter) appendDigitPart(dst []byte, k PartKind, d byte) []byte {
+	start := len(dst)
+	dst = f.AppendDigit(dst, d)
+	f.mark(k, start, dst)
+	return dst
+}
@@ -65,6 +65,10 @@ type RoundingContext struct {
 
 	Mode RoundingMode
 
+	// Priority determines how MaxSignificantDigits and MaxFractionDigits are
+	// reconciled if both are set.
+	Priority RoundingPriority
+
 	DigitShift uint8 // Number of decimals to shift. Used for % and ‰.
 
 	// Number of digits.
@@ -77,13 +81,25 @@ type RoundingContext struct {
 	MinExponentDigits uint8
 }
 
+// A RoundingPriority determines which of the limits on the number of
+// significant digits and fraction digits takes effect if both are set.
+type RoundingPriority uint8
+
+const (
+	LessPrecision RoundingPriority = iota // the limit that results in fewer digits
+	MorePrecision                         // the limit that results in more digits
+)
+
 // RoundSignificantDigits returns the number of significant digits an
 // implementation of Convert may round to or n < 0 if there is no maximum or
 // a maximum is not recommended.
 func (r *RoundingContext) RoundSignificantDigits() (n int) {
 	if r.MaxFractionDigits == 0 && r.MaxSignificantDigits > 0 {
+		if r.Priority == MorePrecision {
+			return -1
+		}
 		return int(r.MaxSignificantDigits)
-	} else if r.isScientific() && r.MaxIntegerDigits == 1 {
+	} else if r.isScientific() && r.MaxIntegerDigits == 1 && r.MaxFractionDigits >= 0 {
 		if r.MaxSignificantDigits == 0 ||
 			int(r.MaxFractionDigits+1) == int(r.MaxSignificantDigits) {
 			// Note: don't add DigitShift: it is only used for decimals.
@@ -336,6 +336,20 @@ func Var(name string, msg ...Message) Message {
 	return &catmsg.Var{Name: name, Message: catmsg.FirstOf(msg)}
 }
 
+// ArgNames defines the names of the substitution arguments of a message, where
+// the i-th name is the name of argument i+1. This allows a renderer, such as
+// the message package, to accept the arguments by name. ArgNames does not
+// produce any output and must precede the other Messages in a sequence:
+//
+//	catalog.Set(language.English, "%[1]s has %[2]d messages.",
+//		catalog.ArgNames("User", "Count"),
+//		plural.Selectf(2, "%d",
+//			plural.One, "%[1]s has 1 message.",
+//			plural.Other, "%[1]s has %[2]d messages."))
+func ArgNames(names ...string) Message {
+	return catmsg.ArgNames(names)
+}
+
 // Context returns a Context for formatting messages.
 // Only one Message may be formatted per context at any given time.
 func (b *Builder) Context(tag language.Tag, r catmsg.Renderer) *Context {
@@ -56,6 +56,17 @@
 //	p.Printf(message.Key("archive(noun)", "archive"))
 //	p.Printf(message.Key("archive(verb)", "archive"))
 //
+// Messages generated by gotext record the names of their placeholders, which
+// allows passing the arguments by name with a single Args value or struct:
+//
+//	p.Printf("%[1]s has %[2]d new messages.", message.Args{"User": u, "Count": n})
+//
+// Errors created with NewError are translated when they are printed, so that
+// they can be reported in the language of the user:
+//
+//	err := message.NewError("%d files could not be copied: %w", n, err)
+//	p.Println(err)
+//
 // # Translation Pipeline
 //
 // Format strings that contain text need to be translated to support different
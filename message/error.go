@@ -0,0 +1,56 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package message
+
+import "golang.org/x/text/language"
+
+// An Error is an error with a message that is translated when it is printed
+// rather than when it is created. This allows an error to be created deep
+// within a program and be reported later in the language of the user.
+//
+// Printing an Error with a Printer, for instance with p.Sprint(err) or as an
+// argument for a %v verb, renders its message in the language of the Printer
+// using the Printer's catalog.
+type Error struct {
+	key  Reference
+	args []interface{}
+}
+
+// NewError returns an Error for the message with the given key and arguments,
+// which are interpreted as for Printer.Sprintf. As with fmt.Errorf, the verb
+// %w may be used to format an error argument.
+//
+// Calls to NewError are recognized by gotext extract.
+func NewError(key Reference, a ...interface{}) *Error {
+	return &Error{key: key, args: a}
+}
+
+// Error implements error. It renders the message for the undetermined
+// language using DefaultCatalog, which typically yields the untranslated
+// message.
+func (e *Error) Error() string {
+	return e.format(NewPrinter(language.Und))
+}
+
+// Unwrap returns the first argument of e that is an error, or nil if there is
+// no such argument.
+func (e *Error) Unwrap() error {
+	for _, a := range e.args {
+		if err, ok := a.(error); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// format renders e for the given Printer.
+func (e *Error) format(p *Printer) string {
+	pp := newPrinter(p)
+	pp.wrapErrs = true
+	lookupAndFormat(pp, e.key, e.args)
+	s := pp.String()
+	pp.free()
+	return s
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package message
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestError(t *testing.T) {
+	cat := catalog.NewBuilder()
+	cat.SetString(language.Dutch, "file %q not found: %w", "bestand %q niet gevonden: %w")
+	cat.SetString(language.Dutch, "%d files left", "%d bestanden over")
+	cat.SetString(language.Dutch, "failed: %v", "mislukt: %v")
+	cat.SetString(language.Dutch, "disk full", "schijf vol")
+	en := NewPrinter(language.English, Catalog(cat))
+	nl := NewPrinter(language.Dutch, Catalog(cat))
+
+	errFull := NewError("disk full")
+	testCases := []struct {
+		err    *Error
+		p      *Printer
+		format string
+		want   string
+	}{
+		{NewError("%d files left", 1234), nil, "", "1,234 files left"},
+		{NewError("%d files left", 1234), en, "%v", "1,234 files left"},
+		{NewError("%d files left", 1234), nl, "%v", "1.234 bestanden over"},
+		{NewError("%d files left", 1234), nl, "%q", `"1.234 bestanden over"`},
+		{NewError("%d files left", 1234), nl, "failed: %v", "mislukt: 1.234 bestanden over"},
+		{NewError("file %q not found: %w", "a.txt", fs.ErrNotExist), nil, "", `file "a.txt" not found: file does not exist`},
+		{NewError("file %q not found: %w", "a.txt", errFull), nl, "%s", `bestand "a.txt" niet gevonden: schijf vol`},
+		{NewError("%w", "x"), nil, "", "%!w(string=x)"},
+		{NewError(Key("id", "fallback %d"), 1), nl, "%v", "fallback 1"},
+	}
+	for _, tc := range testCases {
+		var got string
+		if tc.p == nil {
+			got = tc.err.Error()
+		} else {
+			got = tc.p.Sprintf(tc.format, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("%v: got %q; want %q", tc.err.args, got, tc.want)
+		}
+	}
+
+	// %w is only supported in the message of an Error.
+	if got, want := nl.Sprintf("%w", errFull), "%!w(*message.Error=&{disk full []})"; got != want {
+		t.Errorf("Sprintf(%%w): got %q; want %q", got, want)
+	}
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	inner := NewError("disk full")
+	err := error(NewError("file %q not found: %w", "a.txt", inner))
+	if got := errors.Unwrap(err); got != inner {
+		t.Errorf("Unwrap: got %v; want %v", got, inner)
+	}
+	if !errors.Is(err, inner) {
+		t.Errorf("Is: got false; want true")
+	}
+	err = NewError("file %q not found: %w", "a.txt", &fs.PathError{Op: "open", Path: "a.txt", Err: fs.ErrNotExist})
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Is(fs.ErrNotExist): got false; want true")
+	}
+	var pe *fs.PathError
+	if !errors.As(err, &pe) || pe.Path != "a.txt" {
+		t.Errorf("As: got %v; want path error for a.txt", pe)
+	}
+	if got := errors.Unwrap(NewError("no error %d", 1)); got != nil {
+		t.Errorf("Unwrap: got %v; want nil", got)
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"net/http"
 
+	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
 )
 
 func Example_http() {
@@ -68,3 +70,44 @@ func ExamplePrinter_mVerb() {
 	// en-GB  You have chosen to play hockey.
 	// nl     U heeft ervoor gekozen om ijshockey te spelen.
 }
+
+func ExampleArgs() {
+	b := catalog.NewBuilder()
+	b.Set(language.English, "%[1]s has %[2]d new message(s).",
+		catalog.ArgNames("User", "Count"),
+		plural.Selectf(2, "%d",
+			plural.One, "%[1]s has one new message.",
+			plural.Other, "%[1]s has %[2]d new messages."))
+	p := message.NewPrinter(language.English, message.Catalog(b))
+
+	p.Printf("%[1]s has %[2]d new message(s).", "Ann", 1)
+	fmt.Println()
+	p.Printf("%[1]s has %[2]d new message(s).", message.Args{"Count": 1200, "User": "Ann"})
+	fmt.Println()
+
+	type inbox struct {
+		User  string
+		Count int
+	}
+	p.Printf("%[1]s has %[2]d new message(s).", inbox{User: "Bob", Count: 3})
+	fmt.Println()
+
+	// Output:
+	// Ann has one new message.
+	// Ann has 1,200 new messages.
+	// Bob has 3 new messages.
+}
+
+func ExampleNewError() {
+	message.SetString(language.Dutch, "%d files could not be copied: %w", "%d bestanden konden niet worden gekopieerd: %w")
+	message.SetString(language.Dutch, "disk full", "schijf vol")
+
+	err := message.NewError("%d files could not be copied: %w", 1200, message.NewError("disk full"))
+
+	fmt.Println(err)
+	message.NewPrinter(language.Dutch).Println(err)
+
+	// Output:
+	// 1,200 files could not be copied: disk full
+	// 1.200 bestanden konden niet worden gekopieerd: schijf vol
+}
@@ -0,0 +1,39 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icu_test
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+	"golang.org/x/text/message/icu"
+)
+
+func ExampleParse() {
+	b := catalog.NewBuilder()
+	msg, err := icu.Parse(
+		"{count, plural, offset:1 =0 {Nobody liked it.} =1 {{name} liked it.} one {{name} and one other person liked it.} other {{name} and # other people liked it.}}",
+		"name", "count")
+	if err != nil {
+		panic(err)
+	}
+	b.Set(language.English, "likes", msg)
+
+	p := message.NewPrinter(language.English, message.Catalog(b))
+	p.Printf("likes", "Ann", 0)
+	p.Println()
+	p.Printf("likes", "Ann", 1)
+	p.Println()
+	p.Printf("likes", "Ann", 2)
+	p.Println()
+	p.Printf("likes", "Ann", 1235)
+	p.Println()
+
+	// Output:
+	// Nobody liked it.
+	// Ann liked it.
+	// Ann and one other person liked it.
+	// Ann and 1,234 other people liked it.
+}
@@ -0,0 +1,214 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package icu compiles messages in the ICU MessageFormat syntax into catalog
+// messages.
+//
+// ICU MessageFormat is the message syntax used by many translation tools. For
+// example, the message
+//
+//	{count, plural, =0 {No files} one {# file} other {# files}}
+//
+// selects a variant based on the plural form of the count argument. Package
+// icu compiles such messages into the message types of packages catalog,
+// feature/plural, and feature/gender, so that they can be added to a
+// catalog.Builder or be used in generated catalogs.
+//
+// The following syntax is supported:
+//   - {arg} formats an argument as with %v.
+//   - {arg, number} formats a number using the decimal format of the language.
+//     The styles integer, percent, and decimal patterns, such as "#,##0.00",
+//     are supported as well.
+//   - {arg, plural, ...} and {arg, selectordinal, ...} select a variant based
+//     on the cardinal or ordinal plural form of a number. The selectors are
+//     plural forms, such as one and other, and exact values, such as =0.
+//     An offset, such as offset:1, is subtracted from the number before
+//     determining its form. A # in a variant stands for the number minus the
+//     offset.
+//   - {arg, select, ...} selects a variant based on the value of a string
+//     argument, such as its gender.
+//   - Apostrophes quote syntax characters, as in '{', and two apostrophes
+//     denote a single one.
+//
+// Arguments are referred to by number, where {0} is the first argument of the
+// formatting call, or by name, if names are given for the arguments. Date,
+// time, and other argument types are not supported.
+//
+// This package is UNDER CONSTRUCTION and its API may change.
+package icu // import "golang.org/x/text/message/icu"
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/feature/gender"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/catmsg"
+	inumber "golang.org/x/text/internal/number"
+	"golang.org/x/text/message/catalog"
+)
+
+// Parse parses msg in ICU MessageFormat syntax and returns the equivalent
+// catalog message. The arguments of the formatting call are referred to by
+// number in msg, or by name, where argNames holds the names of the arguments in
+// order. If argNames is not empty, the arguments may also be passed by name, as
+// with message.Args.
+func Parse(msg string, argNames ...string) (catalog.Message, error) {
+	p := &parser{src: msg, names: argNames}
+	nodes, err := p.parseMessage(false, false)
+	if err != nil {
+		return nil, err
+	}
+	c := &compiler{}
+	m, err := c.message(nodes, nil)
+	if err != nil || len(argNames) == 0 {
+		return m, err
+	}
+	// Allow the arguments to be passed by name.
+	return catmsg.FirstOf{catmsg.ArgNames(argNames), m}, nil
+}
+
+// MustParse is like Parse, but panics if msg cannot be parsed.
+func MustParse(msg string, argNames ...string) catalog.Message {
+	m, err := Parse(msg, argNames...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// String returns a catalog message for msg in ICU MessageFormat syntax, as
+// Parse does. Any error in msg is reported when the message is compiled, for
+// instance, when it is passed to catalog.Builder.Set.
+func String(msg string, argNames ...string) catalog.Message {
+	m, err := Parse(msg, argNames...)
+	if err != nil {
+		return errMessage{err}
+	}
+	return m
+}
+
+type errMessage struct{ err error }
+
+func (m errMessage) Compile(e *catmsg.Encoder) error { return m.err }
+
+// A compiler converts parsed messages to catalog messages.
+type compiler struct {
+	numVars int
+}
+
+// message compiles nodes. pl is the innermost enclosing plural argument, if
+// any.
+func (c *compiler) message(nodes []node, pl *selectNode) (catalog.Message, error) {
+	if len(nodes) == 1 {
+		if n, ok := nodes[0].(*selectNode); ok {
+			return c.selectMessage(n, pl)
+		}
+	}
+	var msgs []catalog.Message
+	var b strings.Builder
+	addVar := func(m catalog.Message) {
+		c.numVars++
+		name := fmt.Sprintf("icu%d", c.numVars)
+		msgs = append(msgs, catalog.Var(name, m))
+		fmt.Fprintf(&b, "${%s}", name)
+	}
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case text:
+			s := strings.ReplaceAll(string(n), "%", "%%")
+			if strings.Contains(s, "${") {
+				// Prevent a literal "${" from being interpreted as a
+				// variable substitution.
+				if !hasDollar(msgs) {
+					msgs = append(msgs, catalog.Var("$", catmsg.Raw("$")))
+				}
+				s = strings.ReplaceAll(s, "${", "${$}{")
+			}
+			b.WriteString(s)
+		case *argNode:
+			if n.typ == "" {
+				fmt.Fprintf(&b, "%%[%d]v", n.arg)
+				continue
+			}
+			m, err := numberArg(n)
+			if err != nil {
+				return nil, err
+			}
+			addVar(m)
+		case poundNode:
+			addVar(&numberMessage{arg: pl.arg, offset: pl.offset})
+		case *selectNode:
+			m, err := c.selectMessage(n, pl)
+			if err != nil {
+				return nil, err
+			}
+			addVar(m)
+		}
+	}
+	msg := catalog.String(b.String())
+	if len(msgs) == 0 {
+		return msg, nil
+	}
+	return catmsg.FirstOf(append(msgs, msg)), nil
+}
+
+func hasDollar(msgs []catalog.Message) bool {
+	for _, m := range msgs {
+		if v, ok := m.(*catmsg.Var); ok && v.Name == "$" {
+			return true
+		}
+	}
+	return false
+}
+
+// numberArg returns the message for a simple argument with a type.
+func numberArg(n *argNode) (catalog.Message, error) {
+	if n.typ != "number" {
+		return nil, fmt.Errorf("icu: argument type %q not supported", n.typ)
+	}
+	m := &numberMessage{arg: n.arg}
+	switch n.style {
+	case "":
+	case "integer":
+		m.style = styleInteger
+	case "percent":
+		m.style = stylePercent
+	default:
+		if strings.HasPrefix(n.style, "::") {
+			return nil, fmt.Errorf("icu: number skeleton %q not supported", n.style)
+		}
+		if _, err := inumber.ParsePattern(n.style); err != nil {
+			return nil, fmt.Errorf("icu: invalid number style %q: %v", n.style, err)
+		}
+		m.style = stylePattern
+		m.pattern = n.style
+	}
+	return m, nil
+}
+
+// selectMessage returns the message for a plural, selectordinal, or select
+// argument.
+func (c *compiler) selectMessage(n *selectNode, pl *selectNode) (catalog.Message, error) {
+	if n.typ != "select" {
+		pl = n
+	}
+	var cases []interface{}
+	for _, x := range n.cases {
+		m, err := c.message(x.msg, pl)
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, x.selector, m)
+	}
+	switch {
+	case n.typ == "select":
+		return gender.Select(n.arg, cases...), nil
+	case n.offset != 0:
+		return &offsetMessage{n.arg, n.offset, n.typ == "selectordinal", cases}, nil
+	case n.typ == "selectordinal":
+		return plural.SelectOrdinalf(n.arg, "", cases...), nil
+	}
+	return plural.Selectf(n.arg, "", cases...), nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icu_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/feature/gender"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+	"golang.org/x/text/message/icu"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		lang  string
+		msg   string
+		names []string
+		args  []interface{}
+		want  string
+	}{
+		{"en", "Hello, world!", nil, nil, "Hello, world!"},
+		{"en", "100% {0}", nil, args("sure"), "100% sure"},
+		{"en", "Costs ${0}", nil, args(3), "Costs $3"},
+		{"en", "'{'literal'}' it''s {0}", nil, args("x"), "{literal} it's x"},
+		{"en", "'{0}' and {1}", nil, args("a", "b"), "{0} and b"},
+		{"en", "{name} is {age}", []string{"name", "age"}, args("Ann", 32), "Ann is 32"},
+		{"en", "{0, number}", nil, args(1234.5), "1,234.5"},
+		{"de", "{0, number}", nil, args(1234.5), "1.234,5"},
+		{"en", "{0, number, integer}", nil, args(1234.5), "1,234"},
+		{"en", "{0, number, percent}", nil, args(0.25), "25%"},
+		{"en", "{0, number, #,##0.00}", nil, args(1234), "1,234.00"},
+		{"en", "{0, number}", nil, args(big.NewInt(1234567)), "1,234,567"},
+		{"en", "{0, number}", nil, args("1234.5"), "1,234.5"},
+		{"en", "{0, number}", nil, args(nil), "<nil>"},
+		{"en", "{1, number}", nil, args(1), "%!v(BADINDEX)"},
+
+		{"en", "{0, plural, one {# file} other {# files}}", nil, args(1), "1 file"},
+		{"en", "{0, plural, one {# file} other {# files}}", nil, args(1234), "1,234 files"},
+		{"en", "{0, plural, =0 {none} one {# file} other {# files}}", nil, args(0), "none"},
+		{"en", "{0, plural, one {one} other {other}}", nil, args(1.5), "other"},
+		{"ru", "{0, plural, one {# файл} few {# файла} many {# файлов} other {# файла}}", nil, args(22), "22 файла"},
+		{"en", "Deleted {0, plural, one {# file} other {# files}}.", nil, args(2), "Deleted 2 files."},
+		{"en", "{0, plural, other {'#' is #}}", nil, args(3), "# is 3"},
+
+		{"en", "{0, plural, offset:1 =0 {nobody} =1 {just you} one {you and # other} other {you and # others}}", nil, args(0), "nobody"},
+		{"en", "{0, plural, offset:1 =0 {nobody} =1 {just you} one {you and # other} other {you and # others}}", nil, args(1), "just you"},
+		{"en", "{0, plural, offset:1 =0 {nobody} =1 {just you} one {you and # other} other {you and # others}}", nil, args(2), "you and 1 other"},
+		{"en", "{0, plural, offset:1 =0 {nobody} =1 {just you} one {you and # other} other {you and # others}}", nil, args(3), "you and 2 others"},
+		{"en", "{0, selectordinal, offset:1 one {#st} two {#nd} few {#rd} other {#th}}", nil, args(3), "2nd"},
+
+		{"en", "{0, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", nil, args(1), "1st"},
+		{"en", "{0, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", nil, args(22), "22nd"},
+		{"en", "{0, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", nil, args(13), "13th"},
+
+		{"en", "{0, select, female {She} male {He} other {They}} left.", nil, args(gender.Male), "He left."},
+		{"en", "{0, select, female {She} male {He} other {They}} left.", nil, args("x"), "They left."},
+		{"en", "{0, select, a {{1, plural, one {$'{x}' #} other {$'{x}' ##}}} other {none}}", nil, args("a", 2), "${x} 22"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			tag := language.MustParse(tc.lang)
+			b := catalog.NewBuilder()
+			const key = "key"
+			m, err := icu.Parse(tc.msg, tc.names...)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			if err := b.Set(tag, key, m); err != nil {
+				t.Fatalf("Set: unexpected error: %v", err)
+			}
+			p := message.NewPrinter(tag, message.Catalog(b))
+			if got := p.Sprintf(key, tc.args...); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNested(t *testing.T) {
+	const msg = `{hostGender, select,
+		female {{guests, plural, offset:1
+			=0 {{host} has no guests.}
+			=1 {{host} invites {guest} to her party.}
+			one {{host} invites {guest} and one other person to her party.}
+			other {{host} invites {guest} and # other people to her party.}}}
+		other {{guests, plural, offset:1
+			=0 {{host} has no guests.}
+			=1 {{host} invites {guest} to their party.}
+			one {{host} invites {guest} and one other person to their party.}
+			other {{host} invites {guest} and # other people to their party.}}}}`
+	names := []string{"host", "hostGender", "guests", "guest"}
+
+	b := catalog.NewBuilder()
+	if err := b.Set(language.English, "party", icu.String(msg, names...)); err != nil {
+		t.Fatal(err)
+	}
+	p := message.NewPrinter(language.English, message.Catalog(b))
+	testCases := []struct {
+		args []interface{}
+		want string
+	}{
+		{args("Ann", gender.Female, 0, "Bob"), "Ann has no guests."},
+		{args("Ann", gender.Female, 1, "Bob"), "Ann invites Bob to her party."},
+		{args("Ann", gender.Female, 2, "Bob"), "Ann invites Bob and one other person to her party."},
+		{args("Sam", gender.Other, 12, "Bob"), "Sam invites Bob and 11 other people to their party."},
+		{args(message.Args{"host": "Ann", "hostGender": gender.Female, "guests": 2, "guest": "Bob"}), "Ann invites Bob and one other person to her party."},
+		{args(message.Args{"guest": "Bob", "guests": 12, "host": "Sam"}), "Sam invites Bob and 11 other people to their party."},
+	}
+	for _, tc := range testCases {
+		if got := p.Sprintf("party", tc.args...); got != tc.want {
+			t.Errorf("%v: got %q; want %q", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	testCases := []struct {
+		msg  string
+		err  string
+		lang language.Tag
+	}{
+		{msg: "{0", err: "missing '}'"},
+		{msg: "}", err: "unmatched '}'"},
+		{msg: "{}", err: "missing argument name"},
+		{msg: "{name}", err: `unknown argument "name"`},
+		{msg: "{01}", err: `invalid argument number "01"`},
+		{msg: "{0,}", err: "missing argument type"},
+		{msg: "{0 1}", err: `expected ','`},
+		{msg: "{0, number", err: "missing '}'"},
+		{msg: "{0, date}", err: `argument type "date" not supported`},
+		{msg: "{0, number, ::currency/EUR}", err: "skeleton"},
+		{msg: "{0, plural, one {x}}", err: "without 'other' case"},
+		{msg: "{0, plural, offset:x other {x}}", err: "invalid offset"},
+		{msg: "{0, plural, other {x}", err: "missing '}'"},
+		{msg: "{0, plural, other x}", err: `expected '{'`},
+		{msg: "{0, plural, {x} other {y}}", err: "missing selector"},
+		{msg: "{0, plural, =x {x} other {y}}", err: "invalid number"},
+		{msg: "{0, plural, bogus {x} other {y}}", err: "invalid plural form"},
+		{msg: "{0, plural, few {x} other {y}}", err: "not supported for language", lang: language.English},
+		{msg: "{0, plural, offset:1 few {x} other {y}}", err: "not supported for language", lang: language.English},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			m, err := icu.Parse(tc.msg)
+			if err == nil {
+				if tc.lang == language.Und {
+					tc.lang = language.English
+				}
+				err = catalog.NewBuilder().Set(tc.lang, "key", m)
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("got error %v; want error containing %q", err, tc.err)
+			}
+			if err := catalog.NewBuilder().Set(language.English, "key", icu.String(tc.msg)); err == nil {
+				t.Errorf("String: got nil error; want error")
+			}
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParse did not panic")
+		}
+	}()
+	icu.MustParse("{0")
+}
+
+func args(a ...interface{}) []interface{} { return a }
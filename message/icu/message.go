@@ -0,0 +1,229 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icu
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/catmsg"
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/message/catalog"
+)
+
+// TODO: support date, time, and spellout arguments.
+
+var (
+	numberHandle = catmsg.Register("golang.org/x/text/message/icu:number", executeNumber)
+	offsetHandle = catmsg.Register("golang.org/x/text/message/icu:offset", executeOffset)
+)
+
+// Number styles.
+const (
+	styleDecimal = iota
+	styleInteger
+	stylePercent
+	stylePattern // pattern string follows
+)
+
+// A numberMessage formats a number argument, minus offset, in the given style.
+type numberMessage struct {
+	arg     int
+	offset  int
+	style   int
+	pattern string
+}
+
+func (m *numberMessage) Compile(e *catmsg.Encoder) error {
+	e.EncodeMessageType(numberHandle)
+	e.EncodeUint(uint64(m.arg))
+	e.EncodeUint(uint64(m.offset))
+	e.EncodeUint(uint64(m.style))
+	if m.style == stylePattern {
+		e.EncodeString(m.pattern)
+	}
+	return nil
+}
+
+func executeNumber(d *catmsg.Decoder) bool {
+	argN := int(d.DecodeUint())
+	offset := int(d.DecodeUint())
+	style := int(d.DecodeUint())
+	pattern := ""
+	if style == stylePattern {
+		pattern = d.DecodeString()
+	}
+
+	arg := d.Arg(argN)
+	x, ok := toRat(arg)
+	if !ok {
+		// Let the printer report the missing or invalid argument.
+		d.Render(fmt.Sprintf("%%[%d]v", argN))
+		return true
+	}
+	if offset != 0 {
+		arg = x.Sub(x, big.NewRat(int64(offset), 1))
+	} else if reflect.TypeOf(arg).PkgPath() != "" {
+		// Named types, such as time.Duration, are not recognized by Append.
+		arg = x
+	}
+
+	lang := d.Language()
+	var f number.Formatter
+	switch style {
+	case styleDecimal:
+		f.InitDecimal(lang)
+	case styleInteger:
+		f.InitDecimal(lang)
+		f.SetScale(0)
+	case stylePercent:
+		f.InitPercent(lang)
+	case stylePattern:
+		p, err := number.ParsePattern(pattern)
+		if err != nil {
+			return false
+		}
+		f.InitPattern(lang, p)
+	}
+	s := string(f.Append(nil, arg))
+	d.Render(strings.ReplaceAll(s, "%", "%%"))
+	return true
+}
+
+// An offsetMessage is a plural message for which the form is determined for
+// the value of the argument minus offset.
+type offsetMessage struct {
+	arg     int
+	offset  int
+	ordinal bool
+	cases   []interface{} // pairs of string and catalog.Message
+}
+
+func (m *offsetMessage) Compile(e *catmsg.Encoder) error {
+	e.EncodeMessageType(offsetHandle)
+	e.EncodeUint(uint64(m.arg))
+	e.EncodeUint(uint64(m.offset))
+	rules := plural.Cardinal
+	if m.ordinal {
+		rules = plural.Ordinal
+		e.EncodeUint(1)
+	} else {
+		e.EncodeUint(0)
+	}
+	forms := rules.Forms(e.Language())
+
+	for i := 0; i < len(m.cases); i += 2 {
+		sel := m.cases[i].(string)
+		if strings.HasPrefix(sel, "=") {
+			x, err := strconv.ParseUint(sel[1:], 10, 16)
+			if err != nil {
+				return fmt.Errorf("icu: invalid number in selector %q", sel)
+			}
+			e.EncodeUint('=')
+			e.EncodeUint(x)
+		} else {
+			f, ok := formMap[sel]
+			if !ok || !hasForm(forms, f) {
+				return fmt.Errorf("icu: form %q not supported for language %q", sel, e.Language())
+			}
+			e.EncodeUint(uint64(f))
+		}
+		if err := e.EncodeMessage(m.cases[i+1].(catalog.Message)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var formMap = map[string]plural.Form{
+	"other": plural.Other,
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+}
+
+func hasForm(forms []plural.Form, f plural.Form) bool {
+	for _, x := range forms {
+		if x == f {
+			return true
+		}
+	}
+	return false
+}
+
+func executeOffset(d *catmsg.Decoder) bool {
+	lang := d.Language()
+	argN := int(d.DecodeUint())
+	offset := int(d.DecodeUint())
+	rules := plural.Cardinal
+	if d.DecodeUint() == 1 {
+		rules = plural.Ordinal
+	}
+
+	form := plural.Other
+	n := -1
+	if x, ok := toRat(d.Arg(argN)); ok {
+		if x.IsInt() && x.Num().IsInt64() {
+			n = int(x.Num().Int64())
+		}
+		x.Sub(x, big.NewRat(int64(offset), 1))
+
+		var f number.Formatter
+		f.InitDecimal(lang)
+		var dec number.Decimal
+		dec.Convert(f.RoundingContext, x)
+		v := number.FormatDigits(&dec, f.RoundingContext)
+		if !v.NaN && !v.Inf {
+			form = rules.MatchDigits(lang, v.Digits, int(v.Exp), v.NumFracDigits())
+		}
+	}
+	for !d.Done() {
+		f := d.DecodeUint()
+		if (f == '=' && n == int(d.DecodeUint())) ||
+			form == plural.Form(f) ||
+			plural.Other == plural.Form(f) {
+			return d.ExecuteMessage()
+		}
+		d.SkipMessage()
+	}
+	return false
+}
+
+// toRat converts a numeric argument to a new big.Rat.
+func toRat(arg interface{}) (*big.Rat, bool) {
+	switch x := arg.(type) {
+	case nil:
+		return nil, false
+	case *big.Int:
+		return new(big.Rat).SetInt(x), true
+	case *big.Float:
+		r, _ := x.Rat(nil)
+		return r, r != nil
+	case *big.Rat:
+		return new(big.Rat).Set(x), true
+	case string:
+		return new(big.Rat).SetString(x)
+	}
+	v := reflect.ValueOf(arg)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewRat(v.Int(), 1), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		r := new(big.Rat)
+		if r.SetFloat64(v.Float()) == nil {
+			return nil, false
+		}
+		return r, true
+	}
+	return nil, false
+}
@@ -0,0 +1,298 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A node is a part of a parsed message: text, *argNode, poundNode, or
+// *selectNode.
+type node interface{}
+
+// text is literal text.
+type text string
+
+// An argNode is a simple argument, such as {0} or {count, number, integer}.
+type argNode struct {
+	arg        int // 1-based, as in printf
+	typ, style string
+}
+
+// poundNode is the # in a plural message, which stands for the number of the
+// enclosing plural argument minus its offset.
+type poundNode struct{}
+
+// A selectNode is a plural, selectordinal, or select argument.
+type selectNode struct {
+	arg    int
+	typ    string
+	offset int
+	cases  []selectCase
+}
+
+type selectCase struct {
+	selector string
+	msg      []node
+}
+
+// parser parses messages in ICU MessageFormat syntax.
+type parser struct {
+	src   string
+	pos   int
+	names []string // argument names for positions 1..n
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("icu: %s at position %d of %q", fmt.Sprintf(format, args...), p.pos, p.src)
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.src) }
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		if p.done() {
+			return p.errorf("missing %q", c)
+		}
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+// parseMessage parses message text up to an unmatched '}' or the end of the
+// input. A # is parsed as a poundNode if inPlural is set.
+func (p *parser) parseMessage(nested, inPlural bool) ([]node, error) {
+	var nodes []node
+	var buf strings.Builder
+	flush := func() {
+		if buf.Len() > 0 {
+			nodes = append(nodes, text(buf.String()))
+			buf.Reset()
+		}
+	}
+	for !p.done() {
+		switch c := p.src[p.pos]; {
+		case c == '\'':
+			p.parseQuoted(&buf, inPlural)
+		case c == '{':
+			flush()
+			p.pos++
+			n, err := p.parseArg(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, n)
+		case c == '}':
+			if !nested {
+				return nil, p.errorf("unmatched '}'")
+			}
+			flush()
+			return nodes, nil
+		case c == '#' && inPlural:
+			flush()
+			p.pos++
+			nodes = append(nodes, poundNode{})
+		default:
+			buf.WriteByte(c)
+			p.pos++
+		}
+	}
+	if nested {
+		return nil, p.errorf("missing '}'")
+	}
+	flush()
+	return nodes, nil
+}
+
+// parseQuoted parses an apostrophe at the current position. Two apostrophes
+// denote a single one. An apostrophe followed by a syntax character starts
+// quoted text, which extends to the next single apostrophe. Any other
+// apostrophe is literal.
+func (p *parser) parseQuoted(buf *strings.Builder, inPlural bool) {
+	p.pos++
+	switch c := p.peek(); {
+	case c == '\'':
+		buf.WriteByte('\'')
+		p.pos++
+		return
+	case c == '{' || c == '}' || c == '|' || c == '#' && inPlural:
+	default:
+		buf.WriteByte('\'')
+		return
+	}
+	for !p.done() {
+		c := p.src[p.pos]
+		p.pos++
+		if c != '\'' {
+			buf.WriteByte(c)
+		} else if p.peek() == '\'' {
+			buf.WriteByte('\'')
+			p.pos++
+		} else {
+			return
+		}
+	}
+}
+
+// parseArg parses an argument after its opening brace.
+func (p *parser) parseArg(inPlural bool) (node, error) {
+	p.skipSpace()
+	name := p.parseIdent()
+	if name == "" {
+		return nil, p.errorf("missing argument name")
+	}
+	arg, err := p.argNum(name)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	switch {
+	case p.done():
+		return nil, p.errorf("missing '}'")
+	case p.peek() == '}':
+		p.pos++
+		return &argNode{arg: arg}, nil
+	}
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	typ := p.parseIdent()
+	if typ == "" {
+		return nil, p.errorf("missing argument type")
+	}
+	p.skipSpace()
+	switch typ {
+	case "plural", "selectordinal", "select":
+		if err := p.expect(','); err != nil {
+			return nil, err
+		}
+		return p.parseSelect(arg, typ, inPlural)
+	}
+	n := &argNode{arg: arg, typ: typ}
+	if p.peek() == ',' {
+		p.pos++
+		i := strings.IndexByte(p.src[p.pos:], '}')
+		if i < 0 {
+			return nil, p.errorf("missing '}'")
+		}
+		n.style = strings.TrimSpace(p.src[p.pos : p.pos+i])
+		p.pos += i
+	}
+	if err := p.expect('}'); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// parseSelect parses the style of a plural, selectordinal, or select
+// argument, up to and including its closing brace.
+func (p *parser) parseSelect(arg int, typ string, inPlural bool) (node, error) {
+	n := &selectNode{arg: arg, typ: typ}
+	if typ != "select" {
+		inPlural = true
+		p.skipSpace()
+		if strings.HasPrefix(p.src[p.pos:], "offset:") {
+			p.pos += len("offset:")
+			p.skipSpace()
+			x, err := strconv.Atoi(p.parseIdent())
+			if err != nil || x < 0 {
+				return nil, p.errorf("invalid offset")
+			}
+			n.offset = x
+		}
+	}
+	hasOther := false
+	for {
+		p.skipSpace()
+		if p.done() {
+			return nil, p.errorf("missing '}'")
+		}
+		if p.peek() == '}' {
+			p.pos++
+			break
+		}
+		sel := p.parseSelector()
+		if sel == "" {
+			return nil, p.errorf("missing selector")
+		}
+		if err := p.expect('{'); err != nil {
+			return nil, err
+		}
+		msg, err := p.parseMessage(true, inPlural)
+		if err != nil {
+			return nil, err
+		}
+		p.pos++ // '}'
+		n.cases = append(n.cases, selectCase{sel, msg})
+		hasOther = hasOther || sel == "other"
+	}
+	if !hasOther {
+		return nil, p.errorf("%s argument without 'other' case", typ)
+	}
+	return n, nil
+}
+
+func (p *parser) parseSelector() string {
+	if p.peek() == '=' {
+		p.pos++
+		return "=" + p.parseIdent()
+	}
+	return p.parseIdent()
+}
+
+// parseIdent parses an argument name or number, a keyword, or a number.
+func (p *parser) parseIdent() string {
+	start := p.pos
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if unicode.IsSpace(r) || strings.ContainsRune("{}',#=:", r) {
+			break
+		}
+		p.pos += size
+	}
+	return p.src[start:p.pos]
+}
+
+// argNum returns the 1-based argument number for an argument name or 0-based
+// argument number.
+func (p *parser) argNum(name string) (int, error) {
+	if c := name[0]; '0' <= c && c <= '9' {
+		x, err := strconv.ParseUint(name, 10, 16)
+		if err != nil || (len(name) > 1 && c == '0') {
+			return 0, p.errorf("invalid argument number %q", name)
+		}
+		return int(x) + 1, nil
+	}
+	for i, s := range p.names {
+		if s == name {
+			return i + 1, nil
+		}
+	}
+	return 0, p.errorf("unknown argument %q", name)
+}
@@ -7,9 +7,12 @@ package message // import "golang.org/x/text/message"
 import (
 	"io"
 	"os"
+	"reflect"
 
 	// Include features to facilitate generated catalogs.
+	_ "golang.org/x/text/feature/gender"
 	_ "golang.org/x/text/feature/plural"
+	_ "golang.org/x/text/message/icu"
 
 	"golang.org/x/text/internal/number"
 	"golang.org/x/text/language"
@@ -138,6 +141,10 @@ func (p *Printer) Printf(key Reference, a ...interface{}) (n int, err error) {
 
 func lookupAndFormat(p *printer, r Reference, a []interface{}) {
 	p.fmt.Reset(a)
+	p.named = nil
+	if len(a) == 1 {
+		p.named = a[0]
+	}
 	switch v := r.(type) {
 	case string:
 		if p.catContext.Execute(v) == catalog.ErrNotFound {
@@ -176,6 +183,59 @@ func (p *printer) Render(msg string) {
 	p.doPrintf(msg)
 }
 
+// SetArgNames implements catmsg.ArgNamer. If the message was called with a
+// single Args value or struct, the arguments are replaced by the values for
+// the given names.
+func (p *printer) SetArgNames(names []string) {
+	if args, ok := namedArgs(p.named, names); ok {
+		p.fmt.Args = args
+	}
+}
+
+// Args holds substitution arguments by name. A message that defines the names
+// of its arguments, such as a message generated by gotext or one that includes
+// catalog.ArgNames, can be formatted with a single Args value instead of
+// positional arguments:
+//
+//	p.Printf("%[1]s has %[2]d messages.", message.Args{"User": u, "Count": n})
+//
+// A struct, or pointer to a struct, whose exported fields have the names of
+// the arguments may be used instead of Args. Missing arguments are nil.
+type Args map[string]interface{}
+
+// namedArgs returns the positional arguments for the given names from an Args
+// value or a struct. It reports false if v does not provide named arguments.
+func namedArgs(v interface{}, names []string) (args []interface{}, ok bool) {
+	if a, ok := v.(Args); ok {
+		args = make([]interface{}, len(names))
+		for i, s := range names {
+			args[i] = a[s]
+		}
+		return args, true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, false
+	}
+	args = make([]interface{}, len(names))
+	for i, s := range names {
+		if s == "" {
+			continue
+		}
+		f, ok := rv.Type().FieldByName(s)
+		if !ok || !f.IsExported() {
+			return nil, false
+		}
+		if x, err := rv.FieldByIndexErr(f.Index); err == nil {
+			args[i] = x.Interface()
+		}
+	}
+	return args, true
+}
+
 // A Reference is a string or a message reference.
 type Reference interface {
 	// TODO: also allow []string
@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
+	"golang.org/x/text/date"
+	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/internal"
 	"golang.org/x/text/internal/format"
 	"golang.org/x/text/language"
@@ -36,6 +39,9 @@ func TestBinding(t *testing.T) {
 			}),
 			"en",
 		},
+		{"en", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "Jan 2, 2006, 3:04:05 PM"},
+		{"de", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "02.01.2006, 15:04:05"},
+		{"ja", date.Date(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), date.Long), "2006年1月2日"},
 	}
 	for i, tc := range testCases {
 		p := NewPrinter(language.MustParse(tc.tag))
@@ -178,6 +184,52 @@ func TestLocalization(t *testing.T) {
 	}
 }
 
+func TestNamedArgs(t *testing.T) {
+	cat := catalog.NewBuilder()
+	cat.Set(language.English, "%[1]s has %[2]d messages",
+		catalog.ArgNames("User", "Count"),
+		plural.Selectf(2, "%d",
+			plural.One, "%[1]s has %[2]d message",
+			plural.Other, "%[1]s has %[2]d messages"))
+	cat.Set(language.Dutch, "%[1]s has %[2]d messages",
+		catalog.ArgNames("User", "Count"),
+		plural.Selectf(2, "%d",
+			plural.One, "%[1]s heeft %[2]d bericht",
+			plural.Other, "%[1]s heeft %[2]d berichten"))
+	cat.SetString(language.English, "plain %v", "Plain %v")
+
+	type msg struct {
+		User  string
+		Count int
+	}
+	type other struct{ Name string }
+	testCases := []struct {
+		tag  string
+		key  string
+		args []interface{}
+		want string
+	}{
+		{"en", "%[1]s has %[2]d messages", []interface{}{"Joe", 1}, "Joe has 1 message"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{Args{"User": "Joe", "Count": 1}}, "Joe has 1 message"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{Args{"Count": 1234, "User": "Joe"}}, "Joe has 1,234 messages"},
+		{"nl", "%[1]s has %[2]d messages", []interface{}{Args{"User": "Joe", "Count": 1}}, "Joe heeft 1 bericht"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{msg{"Mary", 2}}, "Mary has 2 messages"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{&msg{"Mary", 1}}, "Mary has 1 message"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{Args{"User": "Joe"}}, "Joe has %!d(<nil>) messages"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{other{"Joe"}}, "{Joe} has %!d(BADINDEX) messages"},
+		{"en", "%[1]s has %[2]d messages", []interface{}{(*msg)(nil)}, "%!s(*message.msg=<nil>) has %!d(BADINDEX) messages"},
+		{"en", "plain %v", []interface{}{Args{"a": 1}}, "Plain map[a:1]"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s/%v", tc.tag, tc.args), func(t *testing.T) {
+			p := NewPrinter(language.MustParse(tc.tag), Catalog(cat))
+			if got := p.Sprintf(tc.key, tc.args...); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
 type entry struct{ tag, key, msg string }
 
 func initCat(entries []entry) (*catalog.Builder, []language.Tag) {
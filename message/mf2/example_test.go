@@ -0,0 +1,83 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mf2_test
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+	"golang.org/x/text/message/mf2"
+)
+
+func ExampleMessage_Format() {
+	m := mf2.MustParse(`
+.input {$count :number}
+.match $count
+0   {{{$name} has no new messages.}}
+one {{{$name} has {$count} new message.}}
+*   {{{$name} has {$count} new messages.}}`, "name", "count")
+
+	for _, n := range []int{0, 1, 1234} {
+		s, _ := m.Format(language.English, "Ann", n)
+		fmt.Println(s)
+	}
+	s, _ := m.Format(language.German, "Ann", 1234)
+	fmt.Println(s)
+
+	// Output:
+	// Ann has no new messages.
+	// Ann has 1 new message.
+	// Ann has 1,234 new messages.
+	// Ann has 1.234 new messages.
+}
+
+func ExampleMessage_FormatToSpans() {
+	m := mf2.MustParse("Read the {#link href=$url}{#b}terms{/b}{/link}.", "url")
+
+	s, spans, _ := m.FormatToSpans(language.English, "https://example.com/terms")
+	fmt.Println(s)
+	for _, span := range spans {
+		fmt.Printf("%s %q %v\n", span.Name, s[span.Start:span.End], span.Options)
+	}
+
+	// Output:
+	// Read the terms.
+	// link "terms" map[href:https://example.com/terms]
+	// b "terms" map[]
+}
+
+func ExampleMessage_Compile() {
+	b := catalog.NewBuilder()
+	b.Set(language.English, "greeting", mf2.MustParse(
+		"{#b}{$name}{/b} paid {$amount :currency currency=EUR}.", "name", "amount"))
+
+	p := message.NewPrinter(language.English, message.Catalog(b))
+	p.Printf("greeting", "Ann", 12.5)
+	fmt.Println()
+
+	// Output:
+	// Ann paid €12.50.
+}
+
+func ExampleRegister() {
+	mf2.Register("my:shout", func(t language.Tag, operand interface{}, options map[string]interface{}) (mf2.Value, error) {
+		return shout(fmt.Sprint(operand)), nil
+	})
+
+	m := mf2.MustParse("{$word :my:shout}", "word")
+	s, _ := m.Format(language.English, "hello")
+	fmt.Println(s)
+
+	// Output:
+	// HELLO!
+}
+
+type shout string
+
+func (s shout) Format() string      { return strings.ToUpper(string(s)) + "!" }
+func (s shout) Source() interface{} { return string(s) }
@@ -0,0 +1,531 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mf2
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/date"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// A Function implements a function, such as :number, that can be used to
+// annotate expressions. It is called with the language of the message, the
+// operand of the expression, and its options, and returns the resolved value
+// of the expression.
+//
+// The operand is nil if the expression has no operand. Otherwise it is a
+// string for a literal, the argument for a variable that refers to an
+// argument, or the resolved Value for a variable that refers to a declaration
+// with a function. The options map option names to values of the same kinds.
+// Functions should ignore options they do not recognize.
+type Function func(t language.Tag, operand interface{}, options map[string]interface{}) (Value, error)
+
+// A Value is the resolved value of an expression with a function.
+type Value interface {
+	// Format returns the formatted value.
+	Format() string
+
+	// Source returns the underlying value, such as the number passed to
+	// :number, for use by other functions that take the value as operand.
+	Source() interface{}
+}
+
+// A Selector is a Value that can be used as a selector to choose a variant
+// of a message.
+type Selector interface {
+	Value
+
+	// Select returns the keys that match the value, from best to worst.
+	Select(keys []string) []string
+}
+
+var functions struct {
+	sync.RWMutex
+	m map[string]Function
+}
+
+func init() {
+	functions.m = map[string]Function{
+		"string":   stringFunc,
+		"number":   numberFunc,
+		"integer":  integerFunc,
+		"currency": currencyFunc,
+		"date":     dateFunc,
+		"time":     timeFunc,
+		"datetime": dateTimeFunc,
+	}
+}
+
+// Register registers f as the function with the given name, which must not
+// include the leading colon. Names of functions that are not defined by the
+// specification should have a namespace, as in "my:upper". It is an error to
+// register a function with the name of an existing function.
+func Register(name string, f Function) error {
+	p := &parser{src: name}
+	if p.parseIdentifier() != name || name == "" {
+		return fmt.Errorf("mf2: invalid function name %q", name)
+	}
+	if f == nil {
+		return fmt.Errorf("mf2: nil function %q", name)
+	}
+	functions.Lock()
+	defer functions.Unlock()
+	if _, ok := functions.m[name]; ok {
+		return fmt.Errorf("mf2: function %q already registered", name)
+	}
+	functions.m[name] = f
+	return nil
+}
+
+func lookupFunction(name string) Function {
+	functions.RLock()
+	defer functions.RUnlock()
+	return functions.m[name]
+}
+
+// formatValue formats a value for which no function was specified.
+func formatValue(t language.Tag, v interface{}) (string, error) {
+	switch x := v.(type) {
+	case Value:
+		return x.Format(), nil
+	case string:
+		return x, nil
+	case nil:
+		return "", errNoValue
+	case time.Time:
+		v, err := dateTimeFunc(t, x, nil)
+		if err != nil {
+			return "", err
+		}
+		return v.Format(), nil
+	case currency.Amount:
+		s, _ := x.FormatToParts(t)
+		return s, nil
+	case fmt.Stringer:
+		return x.String(), nil
+	}
+	if _, ok := toNumber(v); ok {
+		v, err := numberFunc(t, v, nil)
+		if err != nil {
+			return "", err
+		}
+		return v.Format(), nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// source returns the underlying value of v if it is a Value.
+func source(v interface{}) interface{} {
+	if x, ok := v.(Value); ok {
+		return x.Source()
+	}
+	return v
+}
+
+// optionString returns the option with the given name as a string.
+func optionString(opts map[string]interface{}, name string) (string, bool) {
+	v, ok := opts[name]
+	if !ok {
+		return "", false
+	}
+	if s, ok := source(v).(string); ok {
+		return s, true
+	}
+	return fmt.Sprint(source(v)), true
+}
+
+// optionInt returns the option with the given name as a small non-negative
+// integer, or -1 if it is not set.
+func optionInt(opts map[string]interface{}, name string) (int, error) {
+	s, ok := optionString(opts, name)
+	if !ok {
+		return -1, nil
+	}
+	x, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || x > 99 {
+		return -1, fmt.Errorf("invalid value %q for option %s", s, name)
+	}
+	return int(x), nil
+}
+
+// stringValue is the value of the :string function.
+type stringValue string
+
+func (v stringValue) Format() string      { return string(v) }
+func (v stringValue) Source() interface{} { return string(v) }
+
+func (v stringValue) Select(keys []string) []string {
+	for _, k := range keys {
+		if k == string(v) {
+			return []string{k}
+		}
+	}
+	return nil
+}
+
+func stringFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	switch x := operand.(type) {
+	case nil:
+		return nil, errNoValue
+	case Value:
+		return stringValue(x.Format()), nil
+	case string:
+		return stringValue(x), nil
+	case fmt.Stringer:
+		return stringValue(x.String()), nil
+	}
+	return stringValue(fmt.Sprint(operand)), nil
+}
+
+// numberValue is the value of the :number and :integer functions.
+type numberValue struct {
+	lang    language.Tag
+	x       interface{}            // a number accepted by the number package
+	opts    map[string]interface{} // options, including inherited ones
+	integer bool
+}
+
+func (v *numberValue) Source() interface{} { return v.x }
+
+func (v *numberValue) formatter() (number.Formatter, error) {
+	var opts []number.Option
+	for _, name := range []string{
+		"minimumIntegerDigits",
+		"minimumFractionDigits",
+		"maximumFractionDigits",
+		"minimumSignificantDigits",
+		"maximumSignificantDigits",
+	} {
+		n, err := optionInt(v.opts, name)
+		if err != nil {
+			return number.Formatter{}, err
+		}
+		if n < 0 {
+			continue
+		}
+		switch name {
+		case "minimumIntegerDigits":
+			opts = append(opts, number.MinIntegerDigits(n))
+		case "minimumFractionDigits":
+			if !v.integer {
+				opts = append(opts, number.MinFractionDigits(n))
+			}
+		case "maximumFractionDigits":
+			if !v.integer {
+				opts = append(opts, number.MaxFractionDigits(n))
+			}
+		case "minimumSignificantDigits":
+			opts = append(opts, number.MinSignificantDigits(n))
+		case "maximumSignificantDigits":
+			opts = append(opts, number.MaxSignificantDigits(n))
+		}
+	}
+	if v.integer {
+		opts = append(opts, number.Scale(0))
+	}
+	switch s, _ := optionString(v.opts, "useGrouping"); s {
+	case "", "auto", "always", "min2", "true":
+	case "never", "false":
+		opts = append(opts, number.NoSeparator())
+	default:
+		return number.Formatter{}, fmt.Errorf("invalid value %q for option useGrouping", s)
+	}
+	if s, ok := optionString(v.opts, "numberingSystem"); ok {
+		opts = append(opts, number.NumberingSystem(s))
+	}
+	switch s, _ := optionString(v.opts, "notation"); s {
+	case "", "standard":
+		return number.Decimal(v.x, opts...), nil
+	case "scientific":
+		return number.Scientific(v.x, opts...), nil
+	case "engineering":
+		return number.Engineering(v.x, opts...), nil
+	case "compact":
+		return number.Compact(v.x, opts...), nil
+	default:
+		return number.Formatter{}, fmt.Errorf("invalid value %q for option notation", s)
+	}
+}
+
+func (v *numberValue) Format() string {
+	f, _ := v.formatter() // errors are reported by numberFunc
+	s, _ := f.FormatToParts(v.lang)
+	return s
+}
+
+func (v *numberValue) Select(keys []string) []string {
+	f, _ := v.formatter()
+	d := f.Digits(nil, v.lang, -1)
+
+	// Exact matches come first.
+	var match []string
+	value, _ := new(big.Rat).SetString(digitsString(d.Digits, int(d.Exp), d.Neg))
+	for _, k := range keys {
+		if x, ok := new(big.Rat).SetString(k); ok && value != nil && x.Cmp(value) == 0 {
+			match = append(match, k)
+		}
+	}
+
+	rules := plural.Cardinal
+	switch s, _ := optionString(v.opts, "select"); s {
+	case "exact":
+		return match
+	case "ordinal":
+		rules = plural.Ordinal
+	}
+	if d.NaN || d.Inf {
+		return match
+	}
+	form := rules.MatchDigits(v.lang, d.Digits, int(d.Exp), d.NumFracDigits())
+	for _, k := range keys {
+		if k == formNames[form] {
+			match = append(match, k)
+		}
+	}
+	return match
+}
+
+var formNames = map[plural.Form]string{
+	plural.Other: "other",
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+}
+
+// digitsString returns the decimal representation of the number with the
+// given digits, which are values between 0 and 9, and exponent.
+func digitsString(digits []byte, exp int, neg bool) string {
+	b := []byte{}
+	if neg {
+		b = append(b, '-')
+	}
+	b = append(b, "0."...)
+	for _, d := range digits {
+		b = append(b, '0'+d)
+	}
+	b = append(b, 'e')
+	return string(strconv.AppendInt(b, int64(exp), 10))
+}
+
+func numberFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	return newNumber(t, operand, opts, false)
+}
+
+func integerFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	return newNumber(t, operand, opts, true)
+}
+
+func newNumber(t language.Tag, operand interface{}, opts map[string]interface{}, integer bool) (Value, error) {
+	v := &numberValue{lang: t, opts: map[string]interface{}{}, integer: integer}
+	if x, ok := operand.(*numberValue); ok {
+		// Inherit the options of a number passed as operand.
+		for k, o := range x.opts {
+			v.opts[k] = o
+		}
+		v.integer = v.integer || x.integer
+	}
+	for k, o := range opts {
+		v.opts[k] = o
+	}
+	x, ok := toNumber(source(operand))
+	if !ok {
+		return nil, fmt.Errorf("operand %v is not a number", operand)
+	}
+	v.x = x
+	if _, err := v.formatter(); err != nil {
+		return nil, err
+	}
+	switch s, _ := optionString(v.opts, "select"); s {
+	case "", "plural", "ordinal", "exact":
+	default:
+		return nil, fmt.Errorf("invalid value %q for option select", s)
+	}
+	return v, nil
+}
+
+// toNumber returns x as a value accepted by the number package.
+func toNumber(x interface{}) (interface{}, bool) {
+	switch v := x.(type) {
+	case nil:
+		return nil, false
+	case string:
+		if _, ok := new(big.Rat).SetString(v); !ok {
+			return nil, false
+		}
+		return v, true
+	case *big.Int, *big.Float, *big.Rat:
+		return v, true
+	case currency.Amount:
+		return v.Decimal(), true
+	}
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return nil, false
+}
+
+// currencyValue is the value of the :currency function.
+type currencyValue struct {
+	lang   language.Tag
+	amount currency.Amount
+	format currency.Formatter
+}
+
+func (v *currencyValue) Source() interface{} { return v.amount }
+
+func (v *currencyValue) Format() string {
+	s, _ := v.format(v.amount).FormatToParts(v.lang)
+	return s
+}
+
+func currencyFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	v := &currencyValue{lang: t, format: currency.Symbol}
+	switch s, _ := optionString(opts, "currencyDisplay"); s {
+	case "", "symbol":
+	case "narrowSymbol":
+		v.format = currency.NarrowSymbol
+	case "code":
+		v.format = currency.ISO
+	case "name":
+		v.format = currency.Name
+	default:
+		return nil, fmt.Errorf("invalid value %q for option currencyDisplay", s)
+	}
+	x := source(operand)
+	if a, ok := x.(currency.Amount); ok {
+		v.amount = a
+		return v, nil
+	}
+	code, ok := optionString(opts, "currency")
+	if !ok {
+		return nil, fmt.Errorf("missing option currency")
+	}
+	unit, err := currency.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	n, ok := toNumber(x)
+	if !ok {
+		return nil, fmt.Errorf("operand %v is not a number", operand)
+	}
+	v.amount = unit.Amount(n)
+	return v, nil
+}
+
+// dateValue is the value of the :date, :time, and :datetime functions.
+type dateValue struct {
+	lang language.Tag
+	t    time.Time
+	f    date.Formatter
+}
+
+func (v *dateValue) Source() interface{} { return v.t }
+
+func (v *dateValue) Format() string {
+	return string(v.f.AppendFormat(nil, v.lang))
+}
+
+var lengths = map[string]date.Length{
+	"full":   date.Full,
+	"long":   date.Long,
+	"medium": date.Medium,
+	"short":  date.Short,
+}
+
+func optionLength(opts map[string]interface{}, name string, def date.Length) (date.Length, error) {
+	s, ok := optionString(opts, name)
+	if !ok {
+		return def, nil
+	}
+	l, ok := lengths[s]
+	if !ok {
+		return def, fmt.Errorf("invalid value %q for option %s", s, name)
+	}
+	return l, nil
+}
+
+// toTime returns the time for a time.Time value or a string in RFC 3339 or
+// ISO 8601 date format.
+func toTime(operand interface{}) (time.Time, error) {
+	switch x := source(operand).(type) {
+	case time.Time:
+		return x, nil
+	case string:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+			if t, err := time.Parse(layout, x); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("operand %v is not a date", operand)
+}
+
+func dateFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	tm, err := toTime(operand)
+	if err != nil {
+		return nil, err
+	}
+	l, err := optionLength(opts, "style", date.Medium)
+	if err != nil {
+		return nil, err
+	}
+	return &dateValue{t, tm, date.Date(tm, l)}, nil
+}
+
+func timeFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	tm, err := toTime(operand)
+	if err != nil {
+		return nil, err
+	}
+	l, err := optionLength(opts, "style", date.Short)
+	if err != nil {
+		return nil, err
+	}
+	return &dateValue{t, tm, date.Time(tm, l)}, nil
+}
+
+func dateTimeFunc(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+	tm, err := toTime(operand)
+	if err != nil {
+		return nil, err
+	}
+	_, hasDate := opts["dateStyle"]
+	_, hasTime := opts["timeStyle"]
+	if !hasDate && !hasTime {
+		return &dateValue{t, tm, date.DateTime(tm, date.Medium, date.Medium)}, nil
+	}
+	dl, err := optionLength(opts, "dateStyle", date.Medium)
+	if err != nil {
+		return nil, err
+	}
+	tl, err := optionLength(opts, "timeStyle", date.Short)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !hasTime:
+		return &dateValue{t, tm, date.Date(tm, dl)}, nil
+	case !hasDate:
+		return &dateValue{t, tm, date.Time(tm, tl)}, nil
+	}
+	return &dateValue{t, tm, date.DateTime(tm, dl, tl)}, nil
+}
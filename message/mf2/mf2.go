@@ -0,0 +1,397 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mf2 implements Unicode MessageFormat 2 messages.
+//
+// A MessageFormat 2 message is either a simple pattern, such as
+//
+//	Hello, {$name}! You have {$count :integer} new messages.
+//
+// or a complex message with declarations and a body, which may select a
+// variant based on the values of its selectors:
+//
+//	.input {$count :number}
+//	.match $count
+//	0   {{You have no new messages.}}
+//	one {{You have {$count} new message.}}
+//	*   {{You have {$count} new messages.}}
+//
+// Variables refer to the arguments of a formatting call by name. The names of
+// the arguments are passed to Parse in the order of the arguments.
+//
+// Expressions may be annotated with functions, such as :number, which format
+// a value and determine which variant it selects. The functions :string,
+// :number, :integer, :currency, :date, :time, and :datetime are built in and
+// are implemented using the packages number, currency, date, and
+// feature/plural. Other functions can be added with Register.
+//
+// Markup, such as {#b}bold{/b} or {#img src=|cat.png|/}, is reported by
+// FormatToSpans as spans of the formatted string, so that the caller can render
+// it as appropriate. Markup does not produce any output otherwise.
+//
+// A Message implements catalog.Message and can be added to a catalog.Builder
+// for use with a message.Printer. Functions registered with Register must be
+// registered before a message using them is parsed or formatted.
+//
+// See https://unicode.org/reports/tr35/tr35-messageFormat.html for the
+// specification of the syntax.
+//
+// This package is UNDER CONSTRUCTION and its API may change.
+package mf2 // import "golang.org/x/text/message/mf2"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/internal/catmsg"
+	"golang.org/x/text/language"
+
+	// Register the handles of other message types first to keep the handle
+	// of this package stable.
+	_ "golang.org/x/text/message/icu"
+)
+
+// A Message is a parsed MessageFormat 2 message.
+type Message struct {
+	src   string
+	names []string
+
+	decls     []declaration
+	selectors []*expression
+	variants  []variant
+	pattern   pattern // if there are no selectors
+}
+
+// Parse parses msg in MessageFormat 2 syntax. The arguments of a formatting
+// call are referred to by variables, where argNames holds the names of the
+// arguments in order.
+func Parse(msg string, argNames ...string) (*Message, error) {
+	m := &Message{src: msg, names: argNames}
+	p := &parser{
+		src:      msg,
+		names:    argNames,
+		declared: map[string]bool{},
+		used:     map[string]bool{},
+	}
+	if err := p.parse(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MustParse is like Parse, but panics if msg cannot be parsed.
+func MustParse(msg string, argNames ...string) *Message {
+	m, err := Parse(msg, argNames...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// String returns the source of m.
+func (m *Message) String() string { return m.src }
+
+// A Span is a markup element of a formatted message. Start and End are the
+// byte offsets of the text enclosed by the markup, where Start equals End for
+// standalone markup, such as {#br/}. A span that is not closed extends to the
+// end of the message. Closing markup without matching opening markup is
+// ignored.
+type Span struct {
+	Name       string
+	Options    map[string]string
+	Start, End int
+}
+
+// Format formats m for language t with the given arguments. If an error
+// occurs, Format returns a best-effort result, in which expressions that
+// could not be formatted are replaced by their fallback representation, such
+// as {$name}, along with the first error.
+func (m *Message) Format(t language.Tag, args ...interface{}) (string, error) {
+	s, _, err := m.FormatToSpans(t, args...)
+	return s, err
+}
+
+// FormatToSpans is like Format, but also returns the spans of markup in the
+// result, ordered by their start.
+func (m *Message) FormatToSpans(t language.Tag, args ...interface{}) (string, []Span, error) {
+	c := &context{
+		lang: t,
+		arg: func(i int) interface{} {
+			if i--; uint(i) < uint(len(args)) {
+				return args[i]
+			}
+			return nil
+		},
+	}
+	c.execute(m)
+	return c.buf.String(), c.spans, c.err
+}
+
+var handle = catmsg.Register("golang.org/x/text/message/mf2:message", execute)
+
+// Compile implements catalog.Message. When a message is executed by a
+// message.Printer, markup is omitted and errors are reported inline by the
+// fallback representation of the failing expressions. The arguments may be
+// passed to the Printer by name, as with message.Args.
+func (m *Message) Compile(e *catmsg.Encoder) error {
+	e.EncodeMessageType(handle)
+	e.EncodeString(m.src)
+	e.EncodeUint(uint64(len(m.names)))
+	for _, s := range m.names {
+		e.EncodeString(s)
+	}
+	return nil
+}
+
+// cache maps the encoded source and names of a message to its parsed form.
+var cache sync.Map // string -> *Message
+
+func execute(d *catmsg.Decoder) bool {
+	src := d.DecodeString()
+	names := make([]string, d.DecodeUint())
+	for i := range names {
+		names[i] = d.DecodeString()
+	}
+	key := src + "\x00" + strings.Join(names, "\x00")
+	v, ok := cache.Load(key)
+	if !ok {
+		m, err := Parse(src, names...)
+		if err != nil {
+			d.Render(escape(src))
+			return true
+		}
+		v, _ = cache.LoadOrStore(key, m)
+	}
+	d.SetArgNames(names)
+	c := &context{lang: d.Language(), arg: d.Arg}
+	c.execute(v.(*Message))
+	d.Render(escape(c.buf.String()))
+	return true
+}
+
+// escape escapes s for rendering by a message.Printer.
+func escape(s string) string { return strings.ReplaceAll(s, "%", "%%") }
+
+// A context holds the state of formatting a message.
+type context struct {
+	lang language.Tag
+	arg  func(i int) interface{} // 1-based
+	m    *Message
+	vars map[string]resolved
+
+	buf   strings.Builder
+	spans []Span
+	open  []int // indices of open spans
+	err   error
+}
+
+// resolved is the value of a variable or expression. If err is not nil,
+// fallback is used as its formatted value.
+type resolved struct {
+	value    interface{}
+	fallback string
+	err      error
+}
+
+var errNoValue = errors.New("mf2: no value")
+
+func (c *context) setError(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *context) execute(m *Message) {
+	c.m = m
+	c.vars = map[string]resolved{}
+	for _, d := range m.decls {
+		r := c.resolve(d.expr)
+		if !d.input && r.err != nil {
+			r.fallback = "{$" + d.name + "}"
+		}
+		c.vars[d.name] = r
+	}
+	pat := m.pattern
+	if len(m.selectors) > 0 {
+		pat = c.selectVariant()
+	}
+	c.formatPattern(pat)
+	for _, i := range c.open {
+		c.spans[i].End = c.buf.Len()
+	}
+}
+
+// variable returns the value of the variable with the given name.
+func (c *context) variable(name string) resolved {
+	if r, ok := c.vars[name]; ok {
+		return r
+	}
+	for i, s := range c.m.names {
+		if s == name {
+			return resolved{value: c.arg(i + 1), fallback: "{$" + name + "}"}
+		}
+	}
+	// Unknown variables are rejected by Parse.
+	return resolved{fallback: "{$" + name + "}", err: errNoValue}
+}
+
+func (c *context) operand(o *operand) resolved {
+	if o.isVar {
+		return c.variable(o.value)
+	}
+	return resolved{value: o.value, fallback: "{|" + o.value + "|}"}
+}
+
+// resolve evaluates an expression.
+func (c *context) resolve(e *expression) resolved {
+	var r resolved
+	if e.operand != nil {
+		r = c.operand(e.operand)
+		if r.err != nil || e.function == "" {
+			return r
+		}
+	} else {
+		r.fallback = "{:" + e.function + "}"
+	}
+	fn := lookupFunction(e.function)
+	opts := map[string]interface{}{}
+	for _, o := range e.options {
+		v := c.operand(&o.value)
+		if v.err != nil {
+			r.err = v.err
+			return r
+		}
+		opts[o.name] = v.value
+	}
+	v, err := fn(c.lang, r.value, opts)
+	if err != nil {
+		r.err = fmt.Errorf("mf2: :%s: %v", e.function, err)
+		return r
+	}
+	r.value = v
+	return r
+}
+
+// format returns the formatted value of r.
+func (c *context) format(r resolved) string {
+	if r.err == nil {
+		s, err := formatValue(c.lang, r.value)
+		if err == nil {
+			return s
+		}
+		r.err = err
+	}
+	c.setError(r.err)
+	return r.fallback
+}
+
+func (c *context) formatPattern(pat pattern) {
+	for _, x := range pat {
+		switch x := x.(type) {
+		case string:
+			c.buf.WriteString(x)
+		case *expression:
+			c.buf.WriteString(c.format(c.resolve(x)))
+		case *markup:
+			c.markup(x)
+		}
+	}
+}
+
+func (c *context) markup(m *markup) {
+	if m.kind == markupClose {
+		for i := len(c.open) - 1; i >= 0; i-- {
+			if s := &c.spans[c.open[i]]; s.Name == m.name {
+				s.End = c.buf.Len()
+				c.open = append(c.open[:i], c.open[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+	s := Span{Name: m.name, Start: c.buf.Len(), End: c.buf.Len()}
+	if len(m.options) > 0 {
+		s.Options = map[string]string{}
+		for _, o := range m.options {
+			r := c.operand(&o.value)
+			if r.err == nil {
+				s.Options[o.name], r.err = formatValue(c.lang, r.value)
+			}
+			if r.err != nil {
+				c.setError(r.err)
+			}
+		}
+	}
+	if m.kind == markupOpen {
+		c.open = append(c.open, len(c.spans))
+	}
+	c.spans = append(c.spans, s)
+}
+
+// selectVariant returns the pattern of the best matching variant.
+func (c *context) selectVariant() pattern {
+	m := c.m
+	// prefs[i][key] is the preference of the i-th selector for key, where
+	// lower values are better.
+	prefs := make([]map[string]int, len(m.selectors))
+	for i, e := range m.selectors {
+		prefs[i] = map[string]int{}
+		var keys []string
+		seen := map[string]bool{}
+		for _, v := range m.variants {
+			if k := v.keys[i]; !k.catchAll && !seen[k.value] {
+				seen[k.value] = true
+				keys = append(keys, k.value)
+			}
+		}
+		r := c.resolve(e)
+		if r.err != nil {
+			c.setError(r.err)
+			continue
+		}
+		s, ok := r.value.(Selector)
+		if !ok {
+			c.setError(fmt.Errorf("mf2: selector %s does not support selection", r.fallback))
+			continue
+		}
+		for j, k := range s.Select(keys) {
+			if _, ok := prefs[i][k]; !ok {
+				prefs[i][k] = j
+			}
+		}
+	}
+
+	best := -1
+	var bestRank []int
+	for j, v := range m.variants {
+		rank := make([]int, len(v.keys))
+		ok := true
+		for i, k := range v.keys {
+			if k.catchAll {
+				rank[i] = len(prefs[i])
+			} else if p, match := prefs[i][k.value]; match {
+				rank[i] = p
+			} else {
+				ok = false
+				break
+			}
+		}
+		if ok && (best < 0 || less(rank, bestRank)) {
+			best, bestRank = j, rank
+		}
+	}
+	// Parse ensures there is a variant with only catch-all keys.
+	return m.variants[best].pattern
+}
+
+func less(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
@@ -0,0 +1,219 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mf2
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestFormat(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		lang  string
+		msg   string
+		names []string
+		args  []interface{}
+		want  string
+	}{
+		{"en", "Hello, world!", nil, nil, "Hello, world!"},
+		{"en", "Hello, {$name}!", []string{"name"}, args("Ann"), "Hello, Ann!"},
+		{"en", `Escaped \{ \} \\ and 100%`, nil, nil, `Escaped { } \ and 100%`},
+		{"en", "{|quoted \\| literal|} and {unquoted}", nil, nil, "quoted | literal and unquoted"},
+		{"en", "{{quoted pattern}}", nil, nil, "quoted pattern"},
+		{"en", "  {{quoted pattern}}\n", nil, nil, "quoted pattern"},
+		{"en", "  simple {$x}", []string{"x"}, args(1), "  simple 1"},
+		{"en", "{$n}", []string{"n"}, args(1234.5), "1,234.5"},
+		{"en", "{$n :number}", []string{"n"}, args(1234.5), "1,234.5"},
+		{"de", "{$n :number}", []string{"n"}, args(1234.5), "1.234,5"},
+		{"en", "{$n :number minimumFractionDigits=2}", []string{"n"}, args(3), "3.00"},
+		{"en", "{$n :number maximumFractionDigits=1}", []string{"n"}, args(3.14159), "3.1"},
+		{"en", "{$n :number useGrouping=never}", []string{"n"}, args(12345), "12345"},
+		{"en", "{$n :number minimumIntegerDigits=3}", []string{"n"}, args(7), "007"},
+		{"en", "{$n :number maximumSignificantDigits=2}", []string{"n"}, args(1234), "1,200"},
+		{"en", "{$n :number minimumFractionDigits=$d}", []string{"n", "d"}, args(1, 3), "1.000"},
+		{"en", "{$n :integer}", []string{"n"}, args(1234.5), "1,234"},
+		{"en", "{42 :number}", nil, nil, "42"},
+		{"en", "{$n :number}", []string{"n"}, args(big.NewInt(1234567)), "1,234,567"},
+		{"en", "{$n :number}", []string{"n"}, args("1234.5"), "1,234.5"},
+		{"en", "{$n :currency currency=EUR}", []string{"n"}, args(12.5), "€12.50"},
+		{"en", "{$n :currency currency=EUR currencyDisplay=code}", []string{"n"}, args(12.5), "EUR\u00a012.50"},
+		{"en", "{$a :currency}", []string{"a"}, args(currency.USD.Amount(3)), "$3.00"},
+		{"en", "{$s :string}", []string{"s"}, args(42), "42"},
+		{"en", "{$d :date}", []string{"d"}, args(date), "Mar 5, 2024"},
+		{"en", "{$d :date style=long}", []string{"d"}, args(date), "March 5, 2024"},
+		{"en", "{|2024-03-05| :date style=short}", nil, nil, "3/5/24"},
+		{"en", "{$d :time}", []string{"d"}, args(date), "2:30 PM"},
+		{"en", "{$d :datetime dateStyle=short}", []string{"d"}, args(date), "3/5/24"},
+
+		{"en", ".local $x = {$n :number minimumFractionDigits=1} {{{$x} and {$x :integer}}}", []string{"n"}, args(2), "2.0 and 2"},
+		{"en", ".input {$n :number maximumFractionDigits=0} {{{$n :number minimumIntegerDigits=2}}}", []string{"n"}, args(1.5), "02"},
+
+		{"en", pluralMsg, []string{"count"}, args(0), "You have no new messages."},
+		{"en", pluralMsg, []string{"count"}, args(1), "You have 1 new message."},
+		{"en", pluralMsg, []string{"count"}, args(1234), "You have 1,234 new messages."},
+		{"en", pluralMsg, []string{"count"}, args(1.0), "You have 1 new message."},
+		{"en", ".input {$n :number select=ordinal} .match $n one {{#{$n}st}} two {{#{$n}nd}} few {{#{$n}rd}} * {{#{$n}th}}", []string{"n"}, args(22), "#22nd"},
+		{"en", ".input {$n :number select=exact} .match $n 1 {{exactly one}} one {{never}} * {{other}}", []string{"n"}, args(1), "exactly one"},
+		{"en", ".input {$n :integer} .match $n one {{one}} * {{other}}", []string{"n"}, args(1.2), "one"},
+		{"en", ".input {$n :number maximumFractionDigits=0} .match $n 1 {{one}} * {{other}}", []string{"n"}, args(1.2), "one"},
+		{"ru", ".input {$n :number} .match $n one {{one}} few {{few}} many {{many}} * {{other}}", []string{"n"}, args(22), "few"},
+
+		{"en", genderMsg, []string{"gender", "count"}, args("female", 1), "She has 1 cat."},
+		{"en", genderMsg, []string{"gender", "count"}, args("male", 1), "He has 1 cat."},
+		{"en", genderMsg, []string{"gender", "count"}, args("female", 3), "She has 3 cats."},
+		{"en", genderMsg, []string{"gender", "count"}, args("unknown", 3), "They have 3 cats."},
+		{"en", ".input {$g :string} .input {$n :number} .match $g $n female * {{a}} * 1 {{b}} * * {{c}}", []string{"g", "n"}, args("female", 1), "a"},
+		{"en", ".match {$g :string} male {{he}} * {{they}}", []string{"g"}, args("male"), "he"},
+
+		{"en", "{#b}bold{/b} {#img src=|cat.png| @alt=x/} {#i}italic", nil, nil, "bold  italic"},
+		{"en", "\u2068{$name}\u2069", []string{"name"}, args("Ann"), "\u2068Ann\u2069"},
+		{"en", "{\u2068$name\u2069}", []string{"name"}, args("Ann"), "Ann"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			m, err := Parse(tc.msg, tc.names...)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			got, err := m.Format(language.MustParse(tc.lang), tc.args...)
+			if err != nil {
+				t.Errorf("Format: unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+const pluralMsg = `
+.input {$count :number}
+.match $count
+0   {{You have no new messages.}}
+one {{You have {$count} new message.}}
+*   {{You have {$count} new messages.}}
+`
+
+const genderMsg = `
+.input {$gender :string}
+.input {$count :integer}
+.match $gender $count
+female one {{She has {$count} cat.}}
+female *   {{She has {$count} cats.}}
+male   one {{He has {$count} cat.}}
+male   *   {{He has {$count} cats.}}
+*      one {{They have {$count} cat.}}
+*      *   {{They have {$count} cats.}}
+`
+
+func TestSpans(t *testing.T) {
+	m := MustParse("Click {#link href=$url}here{/link} or {#b}{#i}there{/i}{/b}.{#br/}{#u}end", "url")
+	got, spans, err := m.FormatToSpans(language.English, "https://go.dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Click here or there.end"; got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+	want := []Span{
+		{Name: "link", Options: map[string]string{"href": "https://go.dev"}, Start: 6, End: 10},
+		{Name: "b", Start: 14, End: 19},
+		{Name: "i", Start: 14, End: 19},
+		{Name: "br", Start: 20, End: 20},
+		{Name: "u", Start: 20, End: 23},
+	}
+	if !reflect.DeepEqual(spans, want) {
+		t.Errorf("spans:\ngot  %+v\nwant %+v", spans, want)
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	testCases := []struct {
+		msg   string
+		names []string
+		args  []interface{}
+		want  string
+		err   string
+	}{
+		{"{$n :number}", []string{"n"}, args("abc"), "{$n}", "not a number"},
+		{"{$n :number minimumFractionDigits=x}", []string{"n"}, args(1), "{$n}", "invalid value"},
+		{"{$n :currency}", []string{"n"}, args(1), "{$n}", "missing option currency"},
+		{"{$n :currency currency=XYZW}", []string{"n"}, args(1), "{$n}", ""},
+		{"{|x| :date}", nil, nil, "{|x|}", "not a date"},
+		{"{:number}", nil, nil, "{:number}", "not a number"},
+		{"a {$n} b", []string{"n"}, nil, "a {$n} b", "no value"},
+		{".local $x = {$n :number} {{{$x}}}", []string{"n"}, args("y"), "{$x}", "not a number"},
+		{".input {$n :number} .match $n one {{one}} * {{other}}", []string{"n"}, args("y"), "other", "not a number"},
+		{".local $s = {$n} .match $s one {{one}} * {{other}}", []string{"n"}, args(1), "other", "does not support selection"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			m, err := Parse(tc.msg, tc.names...)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			got, err := m.Format(language.English, tc.args...)
+			if got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("got error %v; want error containing %q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestNamedArgs(t *testing.T) {
+	b := catalog.NewBuilder()
+	b.Set(language.English, "key", MustParse(genderMsg, "gender", "count"))
+	p := message.NewPrinter(language.English, message.Catalog(b))
+	testCases := []struct {
+		args []interface{}
+		want string
+	}{
+		{args("female", 1), "She has 1 cat."},
+		{args(message.Args{"gender": "female", "count": 1}), "She has 1 cat."},
+		{args(message.Args{"count": 3, "gender": "male"}), "He has 3 cats."},
+		{args(message.Args{"count": 3}), "They have 3 cats."},
+	}
+	for _, tc := range testCases {
+		if got := p.Sprintf("key", tc.args...); got != tc.want {
+			t.Errorf("%v: got %q; want %q", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	upper := func(t language.Tag, operand interface{}, opts map[string]interface{}) (Value, error) {
+		s, err := stringFunc(t, operand, opts)
+		if err != nil {
+			return nil, err
+		}
+		return stringValue(strings.ToUpper(s.Format())), nil
+	}
+	if err := Register("test:upper", upper); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"test:upper", "number", "", ":x", "a b"} {
+		if err := Register(name, upper); err == nil {
+			t.Errorf("Register(%q): got nil error; want error", name)
+		}
+	}
+	m := MustParse(".input {$s :test:upper} .match $s ANN {{Hi, {$s}!}} * {{Hello}}", "s")
+	if got, _ := m.Format(language.English, "Ann"); got != "Hi, ANN!" {
+		t.Errorf("got %q; want %q", got, "Hi, ANN!")
+	}
+}
+
+func args(a ...interface{}) []interface{} { return a }
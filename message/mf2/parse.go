@@ -0,0 +1,676 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mf2
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A pattern is a sequence of text, *expression, and *markup parts.
+type pattern []interface{}
+
+// An expression is a placeholder or declared value, such as {$count :number}.
+type expression struct {
+	operand  *operand // nil if the expression has no operand
+	function string   // without the colon; "" if there is no annotation
+	options  []option
+}
+
+// An operand is a literal or a variable.
+type operand struct {
+	isVar bool
+	value string // the literal value or the variable name without the $
+}
+
+type option struct {
+	name  string
+	value operand
+}
+
+type markupKind int
+
+const (
+	markupOpen markupKind = iota
+	markupClose
+	markupStandalone
+)
+
+// markup is a markup placeholder, such as {#b}, {/b}, or {#img/}.
+type markup struct {
+	kind    markupKind
+	name    string
+	options []option
+}
+
+type declaration struct {
+	name  string // variable name without the $
+	input bool
+	expr  *expression
+}
+
+type variant struct {
+	keys    []key
+	pattern pattern
+}
+
+type key struct {
+	value    string
+	catchAll bool
+}
+
+// parser parses messages in MessageFormat 2 syntax.
+type parser struct {
+	src      string
+	pos      int
+	names    []string        // argument names
+	declared map[string]bool // variables declared so far
+	used     map[string]bool // variables referenced so far
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("mf2: %s at position %d of %q", fmt.Sprintf(format, args...), p.pos, p.src)
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.src) }
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) peekRune() rune {
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return r
+}
+
+func (p *parser) hasPrefix(s string) bool {
+	return strings.HasPrefix(p.src[p.pos:], s)
+}
+
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		if p.done() {
+			return p.errorf("missing %q", c)
+		}
+		return p.errorf("expected %q, found %q", c, p.peekRune())
+	}
+	p.pos++
+	return nil
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == '\u3000'
+}
+
+// isBidi reports whether r is a bidirectional mark or isolate, which are
+// allowed around syntax elements.
+func isBidi(r rune) bool {
+	return r == '\u061c' || r == '\u200e' || r == '\u200f' || '\u2066' <= r && r <= '\u2069'
+}
+
+// skipSpace skips optional whitespace and reports whether there was any.
+func (p *parser) skipSpace() bool {
+	space := false
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !isSpace(r) && !isBidi(r) {
+			break
+		}
+		space = space || isSpace(r)
+		p.pos += size
+	}
+	return space
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || r > 0x7f && !isSpace(r) && !isBidi(r) && !unicode.IsPunct(r) && !unicode.IsSymbol(r) && !unicode.IsNumber(r)
+}
+
+func isNameChar(r rune) bool {
+	return isNameStart(r) || '0' <= r && r <= '9' || r == '-' || r == '.' || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r)
+}
+
+// parseName parses a name and returns "" if there is none.
+func (p *parser) parseName() string {
+	start := p.pos
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if p.pos == start && !isNameStart(r) || !isNameChar(r) {
+			break
+		}
+		p.pos += size
+	}
+	return p.src[start:p.pos]
+}
+
+// parseIdentifier parses a name with an optional namespace, as in "u:locale".
+func (p *parser) parseIdentifier() string {
+	name := p.parseName()
+	if name != "" && p.peek() == ':' {
+		p.pos++
+		if s := p.parseName(); s != "" {
+			return name + ":" + s
+		}
+		p.pos--
+	}
+	return name
+}
+
+// parse parses a complete message.
+func (p *parser) parse(m *Message) error {
+	start := p.pos
+	p.skipSpace()
+	if p.peek() != '.' && !p.hasPrefix("{{") {
+		// A simple message.
+		p.pos = start
+		pat, err := p.parsePattern(false)
+		if err != nil {
+			return err
+		}
+		m.pattern = pat
+		return nil
+	}
+	for {
+		p.skipSpace()
+		switch {
+		case p.hasPrefix("{{"):
+			pat, err := p.parseQuotedPattern()
+			if err != nil {
+				return err
+			}
+			m.pattern = pat
+			return p.end()
+		case p.hasPrefix(".input"):
+			p.pos += len(".input")
+			p.skipSpace()
+			d, err := p.parseInput()
+			if err != nil {
+				return err
+			}
+			m.decls = append(m.decls, d)
+		case p.hasPrefix(".local"):
+			p.pos += len(".local")
+			if !p.skipSpace() {
+				return p.errorf("missing space after .local")
+			}
+			d, err := p.parseLocal()
+			if err != nil {
+				return err
+			}
+			m.decls = append(m.decls, d)
+		case p.hasPrefix(".match"):
+			p.pos += len(".match")
+			if err := p.parseMatch(m); err != nil {
+				return err
+			}
+			return p.end()
+		case p.peek() == '.':
+			p.pos++
+			return p.errorf("unsupported statement %q", "."+p.parseName())
+		case p.done():
+			return p.errorf("missing message body")
+		default:
+			return p.errorf("unexpected %q", p.peekRune())
+		}
+	}
+}
+
+func (p *parser) end() error {
+	p.skipSpace()
+	if !p.done() {
+		return p.errorf("unexpected %q after message body", p.peekRune())
+	}
+	return nil
+}
+
+func (p *parser) declare(name string) error {
+	if p.declared[name] || p.used[name] {
+		return p.errorf("duplicate declaration of $%s", name)
+	}
+	p.declared[name] = true
+	return nil
+}
+
+func (p *parser) parseInput() (declaration, error) {
+	if p.peek() != '{' {
+		return declaration{}, p.errorf("expected expression after .input")
+	}
+	start := p.pos
+	used := p.used
+	p.used = map[string]bool{}
+	x, err := p.parsePlaceholder()
+	if err != nil {
+		return declaration{}, err
+	}
+	e, ok := x.(*expression)
+	if !ok || e.operand == nil || !e.operand.isVar {
+		p.pos = start
+		return declaration{}, p.errorf("expected variable expression after .input")
+	}
+	// The variable may be used in its own declaration.
+	name := e.operand.value
+	delete(p.used, name)
+	for k := range p.used {
+		used[k] = true
+	}
+	p.used = used
+	if err := p.declare(name); err != nil {
+		return declaration{}, err
+	}
+	return declaration{name: name, input: true, expr: e}, nil
+}
+
+func (p *parser) parseLocal() (declaration, error) {
+	if err := p.expect('$'); err != nil {
+		return declaration{}, err
+	}
+	name := p.parseName()
+	if name == "" {
+		return declaration{}, p.errorf("missing variable name")
+	}
+	p.skipSpace()
+	if err := p.expect('='); err != nil {
+		return declaration{}, err
+	}
+	p.skipSpace()
+	if p.peek() != '{' {
+		return declaration{}, p.errorf("expected expression after '='")
+	}
+	x, err := p.parsePlaceholder()
+	if err != nil {
+		return declaration{}, err
+	}
+	e, ok := x.(*expression)
+	if !ok {
+		return declaration{}, p.errorf("markup cannot be assigned to a variable")
+	}
+	if err := p.declare(name); err != nil {
+		return declaration{}, err
+	}
+	return declaration{name: name, expr: e}, nil
+}
+
+func (p *parser) parseMatch(m *Message) error {
+	for {
+		space := p.skipSpace()
+		switch {
+		case p.hasPrefix("{{"):
+		case p.peek() == '$':
+			if !space {
+				return p.errorf("missing space before selector")
+			}
+			p.pos++
+			name := p.parseName()
+			if name == "" {
+				return p.errorf("missing variable name")
+			}
+			if !p.declared[name] {
+				return p.errorf("selector $%s must be declared with a function", name)
+			}
+			m.selectors = append(m.selectors, &expression{operand: &operand{isVar: true, value: name}})
+			continue
+		case p.peek() == '{':
+			// Selector expressions are accepted for compatibility with
+			// earlier drafts of the syntax.
+			x, err := p.parsePlaceholder()
+			if err != nil {
+				return err
+			}
+			e, ok := x.(*expression)
+			if !ok {
+				return p.errorf("markup cannot be used as selector")
+			}
+			m.selectors = append(m.selectors, e)
+			continue
+		}
+		break
+	}
+	if len(m.selectors) == 0 {
+		return p.errorf("missing selector")
+	}
+	hasCatchAll := false
+	for {
+		p.skipSpace()
+		if p.done() {
+			break
+		}
+		v, err := p.parseVariant(len(m.selectors))
+		if err != nil {
+			return err
+		}
+		all := true
+		for _, k := range v.keys {
+			all = all && k.catchAll
+		}
+		hasCatchAll = hasCatchAll || all
+		m.variants = append(m.variants, v)
+	}
+	if !hasCatchAll {
+		return p.errorf("missing fallback variant with only * keys")
+	}
+	return nil
+}
+
+func (p *parser) parseVariant(n int) (variant, error) {
+	var v variant
+	space := true
+	for !p.hasPrefix("{{") {
+		switch {
+		case p.done():
+			return v, p.errorf("missing quoted pattern")
+		case !space:
+			return v, p.errorf("missing space between keys")
+		case p.peek() == '*':
+			p.pos++
+			v.keys = append(v.keys, key{catchAll: true})
+		default:
+			s, err := p.parseLiteral()
+			if err != nil {
+				return v, err
+			}
+			v.keys = append(v.keys, key{value: s})
+		}
+		space = p.skipSpace()
+	}
+	if len(v.keys) != n {
+		return v, p.errorf("variant has %d keys; want %d", len(v.keys), n)
+	}
+	pat, err := p.parseQuotedPattern()
+	if err != nil {
+		return v, err
+	}
+	v.pattern = pat
+	return v, nil
+}
+
+// parseLiteral parses a quoted or unquoted literal.
+func (p *parser) parseLiteral() (string, error) {
+	if p.peek() != '|' {
+		// An unquoted literal is a sequence of name characters, such as a
+		// name or a number.
+		start := p.pos
+		for !p.done() {
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			if !isNameChar(r) {
+				break
+			}
+			p.pos += size
+		}
+		if p.pos == start {
+			if p.done() {
+				return "", p.errorf("missing literal")
+			}
+			return "", p.errorf("unexpected %q", p.peekRune())
+		}
+		return p.src[start:p.pos], nil
+	}
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.done() {
+			return "", p.errorf("missing '|'")
+		}
+		switch c := p.src[p.pos]; c {
+		case '|':
+			p.pos++
+			return b.String(), nil
+		case '\\':
+			if err := p.parseEscape(&b); err != nil {
+				return "", err
+			}
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// parseEscape parses an escape sequence, which may escape a backslash, a
+// brace, or a pipe.
+func (p *parser) parseEscape(b *strings.Builder) error {
+	p.pos++
+	switch c := p.peek(); c {
+	case '\\', '{', '}', '|':
+		b.WriteByte(c)
+		p.pos++
+		return nil
+	}
+	return p.errorf("invalid escape sequence")
+}
+
+func (p *parser) parseQuotedPattern() (pattern, error) {
+	if !p.hasPrefix("{{") {
+		return nil, p.errorf("expected quoted pattern")
+	}
+	p.pos += 2
+	pat, err := p.parsePattern(true)
+	if err != nil {
+		return nil, err
+	}
+	if !p.hasPrefix("}}") {
+		return nil, p.errorf("missing \"}}\"")
+	}
+	p.pos += 2
+	return pat, nil
+}
+
+// parsePattern parses text and placeholders up to the end of the input or, if
+// quoted is set, up to an unescaped closing brace.
+func (p *parser) parsePattern(quoted bool) (pattern, error) {
+	var pat pattern
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			pat = append(pat, b.String())
+			b.Reset()
+		}
+	}
+	for !p.done() {
+		switch c := p.src[p.pos]; c {
+		case '\\':
+			if err := p.parseEscape(&b); err != nil {
+				return nil, err
+			}
+		case '{':
+			flush()
+			x, err := p.parsePlaceholder()
+			if err != nil {
+				return nil, err
+			}
+			pat = append(pat, x)
+		case '}':
+			if quoted {
+				flush()
+				return pat, nil
+			}
+			return nil, p.errorf("unmatched '}'")
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	flush()
+	return pat, nil
+}
+
+// parsePlaceholder parses an expression or markup.
+func (p *parser) parsePlaceholder() (interface{}, error) {
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	switch p.peek() {
+	case '#', '/':
+		return p.parseMarkup()
+	}
+	e := &expression{}
+	switch p.peek() {
+	case '$':
+		p.pos++
+		name := p.parseName()
+		if name == "" {
+			return nil, p.errorf("missing variable name")
+		}
+		if err := p.useVar(name); err != nil {
+			return nil, err
+		}
+		e.operand = &operand{isVar: true, value: name}
+	case ':':
+	default:
+		s, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		e.operand = &operand{value: s}
+	}
+	space := e.operand == nil || p.skipSpace()
+	if p.peek() == ':' {
+		if !space {
+			return nil, p.errorf("missing space before function")
+		}
+		p.pos++
+		e.function = p.parseIdentifier()
+		if e.function == "" {
+			return nil, p.errorf("missing function name")
+		}
+		if lookupFunction(e.function) == nil {
+			return nil, p.errorf("unknown function :%s", e.function)
+		}
+		opts, err := p.parseOptions()
+		if err != nil {
+			return nil, err
+		}
+		e.options = opts
+	}
+	if err := p.parseAttributes(); err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if err := p.expect('}'); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (p *parser) parseMarkup() (interface{}, error) {
+	m := &markup{kind: markupOpen}
+	if p.peek() == '/' {
+		m.kind = markupClose
+	}
+	p.pos++
+	if m.name = p.parseIdentifier(); m.name == "" {
+		return nil, p.errorf("missing markup name")
+	}
+	opts, err := p.parseOptions()
+	if err != nil {
+		return nil, err
+	}
+	m.options = opts
+	if err := p.parseAttributes(); err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if m.kind == markupOpen && p.peek() == '/' {
+		p.pos++
+		m.kind = markupStandalone
+	}
+	if err := p.expect('}'); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parseOptions parses a sequence of options, each preceded by whitespace.
+func (p *parser) parseOptions() ([]option, error) {
+	var opts []option
+	for {
+		start := p.pos
+		if !p.skipSpace() || p.peek() == '@' {
+			p.pos = start
+			return opts, nil
+		}
+		name := p.parseIdentifier()
+		if name == "" {
+			p.pos = start
+			return opts, nil
+		}
+		for _, o := range opts {
+			if o.name == name {
+				return nil, p.errorf("duplicate option %q", name)
+			}
+		}
+		p.skipSpace()
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		o := option{name: name}
+		if p.peek() == '$' {
+			p.pos++
+			v := p.parseName()
+			if v == "" {
+				return nil, p.errorf("missing variable name")
+			}
+			if err := p.useVar(v); err != nil {
+				return nil, err
+			}
+			o.value = operand{isVar: true, value: v}
+		} else {
+			s, err := p.parseLiteral()
+			if err != nil {
+				return nil, err
+			}
+			o.value = operand{value: s}
+		}
+		opts = append(opts, o)
+	}
+}
+
+// parseAttributes parses and discards attributes, such as @translate=no,
+// which do not affect formatting.
+func (p *parser) parseAttributes() error {
+	for {
+		start := p.pos
+		if !p.skipSpace() || p.peek() != '@' {
+			p.pos = start
+			return nil
+		}
+		p.pos++
+		if p.parseIdentifier() == "" {
+			return p.errorf("missing attribute name")
+		}
+		start = p.pos
+		p.skipSpace()
+		if p.peek() != '=' {
+			p.pos = start
+			continue
+		}
+		p.pos++
+		p.skipSpace()
+		if _, err := p.parseLiteral(); err != nil {
+			return err
+		}
+	}
+}
+
+// useVar records a reference to a variable, which must be declared or be the
+// name of an argument.
+func (p *parser) useVar(name string) error {
+	p.used[name] = true
+	if p.declared[name] {
+		return nil
+	}
+	for _, s := range p.names {
+		if s == name {
+			return nil
+		}
+	}
+	return p.errorf("unknown variable $%s", name)
+}
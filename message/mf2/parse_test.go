@@ -0,0 +1,63 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mf2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		msg string
+		err string
+	}{
+		{"}", "unmatched '}'"},
+		{"{", "missing literal"},
+		{"{$x", "missing '}'"},
+		{"{$}", "missing variable name"},
+		{"{$y}", "unknown variable $y"},
+		{"{$x:number}", "missing space before function"},
+		{"{$x :}", "missing function name"},
+		{"{$x :bogus}", "unknown function :bogus"},
+		{"{$x :number a}", "expected '='"},
+		{"{$x :number a=1 a=2}", `duplicate option "a"`},
+		{"{|unterminated}", "missing '|'"},
+		{`\n`, "invalid escape sequence"},
+		{"{#}", "missing markup name"},
+		{"{{x}", `missing "}}"`},
+		{"{{x}} y", "after message body"},
+		{".input $x", "expected expression after .input"},
+		{".input {|x|}", "expected variable expression"},
+		{".input {$x :number} .input {$x :string} {{}}", "duplicate declaration of $x"},
+		{".local $y = {$x} .local $y = {$x} {{}}", "duplicate declaration of $y"},
+		{".local $y = {$y} {{}}", "unknown variable $y"},
+		{".local $x = {$x :number} {{}}", "duplicate declaration of $x"},
+		{".local $y = {#b} {{}}", "markup cannot be assigned"},
+		{".local$y = {1} {{}}", "missing space after .local"},
+		{".foo {{}}", `unsupported statement ".foo"`},
+		{".input {$x :number}", "missing message body"},
+		{".match {{}}", "missing selector"},
+		{".match $x * {{}}", "selector $x must be declared"},
+		{".input {$x :number} .match $x one {{}}", "missing fallback variant"},
+		{".input {$x :number} .match $x one two {{}} * {{}}", "variant has 2 keys; want 1"},
+		{".input {$x :number} .match $x one", "missing quoted pattern"},
+		{".input {$x :number} .match $x one{{}} *{{}}", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, err := Parse(tc.msg, "x")
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("got error %v; want error containing %q", err, tc.err)
+			}
+		})
+	}
+}
@@ -125,6 +125,10 @@ func (x *extracter) seedEndpoints() error {
 		argPos:    3,
 		isMethod:  true,
 	})
+	x.handleFunc(pkg.Func("NewError"), &callData{
+		formatPos: 0,
+		argPos:    1,
+	})
 	return nil
 }
 
@@ -217,7 +221,12 @@ func (c *callData) Pos() token.Pos      { return c.call.Pos() }
 func (c *callData) Pkg() *types.Package { return c.call.Parent().Pkg.Pkg }
 
 func (x *extracter) handleFunc(f *ssa.Function, fd *callData) {
-	for _, e := range x.callGraph.Nodes[f].In {
+	node := x.callGraph.Nodes[f]
+	if node == nil {
+		// f is not called.
+		return
+	}
+	for _, e := range node.In {
 		if e.Pos() == 0 {
 			continue
 		}
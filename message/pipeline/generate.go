@@ -16,11 +16,13 @@ import (
 	"text/template"
 
 	"golang.org/x/text/collate"
+	"golang.org/x/text/feature/gender"
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/internal"
 	"golang.org/x/text/internal/catmsg"
 	"golang.org/x/text/internal/gen"
 	"golang.org/x/text/language"
+	"golang.org/x/text/message/icu"
 	"golang.org/x/tools/go/loader"
 )
 
@@ -179,6 +181,10 @@ func (s *State) generate() (*gen.CodeWriter, error) {
 							Suffix:  trailWS,
 						}
 					}
+					if names := msg.argNames(); len(names) > 0 {
+						// Allow the arguments to be passed by name.
+						m = catmsg.FirstOf{catmsg.ArgNames(names), m}
+					}
 					// TODO: support macros.
 					data, err := catmsg.Compile(tag, nil, m)
 					if err != nil {
@@ -228,7 +234,13 @@ func assemble(m *Message, t *Text) (msg catmsg.Message, err error) {
 		}
 		a = append(a, s)
 	}
-	if t.Msg != "" {
+	if t.Msg != "" && t.Format == "icu" {
+		msg, err := icu.Parse(t.Msg, m.argNames()...)
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, msg)
+	} else if t.Msg != "" {
 		sub, err := m.Substitute(t.Msg)
 		if err != nil {
 			return nil, err
@@ -246,6 +258,23 @@ func assemble(m *Message, t *Text) (msg catmsg.Message, err error) {
 	}
 }
 
+// argNames returns the placeholder IDs of m by argument position.
+func (m *Message) argNames() []string {
+	var names []string
+	for _, ph := range m.Placeholders {
+		if ph.ArgNum <= 0 {
+			continue
+		}
+		for len(names) < ph.ArgNum {
+			names = append(names, "")
+		}
+		if names[ph.ArgNum-1] == "" {
+			names[ph.ArgNum-1] = ph.ID
+		}
+	}
+	return names
+}
+
 func assembleSelect(m *Message, s *Select) (msg catmsg.Message, err error) {
 	cases := []string{}
 	for c := range s.Cases {
@@ -269,6 +298,10 @@ func assembleSelect(m *Message, s *Select) (msg catmsg.Message, err error) {
 	case "plural":
 		// TODO: only printf-style selects are supported as of yet.
 		return plural.Selectf(ph.ArgNum, ph.String, caseMsg...), nil
+	case "ordinal":
+		return plural.SelectOrdinalf(ph.ArgNum, ph.String, caseMsg...), nil
+	case "gender", "select":
+		return gender.Select(ph.ArgNum, caseMsg...), nil
 	}
 	return nil, errorf("unknown feature type %q", s.Feature)
 }
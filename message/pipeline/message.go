@@ -159,7 +159,7 @@ type argument struct {
 // Feature holds information about a feature that can be implemented by
 // an Argument.
 type Feature struct {
-	Type string `json:"type"` // Right now this is only gender and plural.
+	Type string `json:"type"` // Right now this is only gender, select, plural, and ordinal.
 
 	// TODO: possible values and examples for the language under consideration.
 
@@ -172,6 +172,12 @@ type Text struct {
 	Msg    string  `json:"msg,omitempty"`
 	Select *Select `json:"select,omitempty"`
 
+	// Format indicates the syntax of Msg. If it is "icu", Msg is a message in
+	// ICU MessageFormat syntax that refers to arguments by placeholder ID, as
+	// in "{count, plural, one {# file} other {# files}}". Otherwise, Msg uses
+	// the placeholder syntax of this package.
+	Format string `json:"format,omitempty"`
+
 	// Var defines a map of variables that may be substituted in the selected
 	// message.
 	Var map[string]Text `json:"var,omitempty"`
@@ -229,7 +235,7 @@ func (id *IDList) MarshalJSON() ([]byte, error) {
 // Select selects a Text based on the feature value associated with a feature of
 // a certain argument.
 type Select struct {
-	Feature string          `json:"feature"` // Name of Feature type (e.g plural)
+	Feature string          `json:"feature"` // Name of Feature type (e.g plural, ordinal, or gender)
 	Arg     string          `json:"arg"`     // The placeholder ID
 	Cases   map[string]Text `json:"cases"`
 }
@@ -72,4 +72,8 @@ func main() {
 	// Multiple substitutions for same argument.
 	miles := 1.2345
 	p.Printf("%.2[1]f miles traveled (%[1]f)", miles)
+
+	// Keys of errors are extracted.
+	err := message.NewError("%s is not available!", device)
+	p.Println(err)
 }
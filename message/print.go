@@ -10,8 +10,10 @@ import (
 	"math"
 	"reflect"
 	"sync"
+	"time"
 	"unicode/utf8"
 
+	"golang.org/x/text/date"
 	"golang.org/x/text/internal/format"
 	"golang.org/x/text/internal/number"
 	"golang.org/x/text/language"
@@ -59,6 +61,8 @@ func newPrinter(pp *Printer) *printer {
 func (p *printer) free() {
 	p.Buffer.Reset()
 	p.arg = nil
+	p.named = nil
+	p.wrapErrs = false
 	p.value = reflect.Value{}
 	printerPool.Put(p)
 }
@@ -82,10 +86,16 @@ type printer struct {
 	// fmt is used to format basic items such as integers or strings.
 	fmt formatInfo
 
+	// named holds the single argument of a message, which may provide the
+	// arguments by name.
+	named interface{}
+
 	// panicking is set by catchPanic to avoid infinite panic, recover, panic, ... recursion.
 	panicking bool
 	// erroring is set when printing an error string to guard against calling handleMethods.
 	erroring bool
+	// wrapErrs is set when the format string may contain a %w verb.
+	wrapErrs bool
 }
 
 // Language implements "golang.org/x/text/internal/format".State.
@@ -594,6 +604,18 @@ func (p *printer) handleMethods(verb rune) (handled bool) {
 	if p.erroring {
 		return
 	}
+	if verb == 'w' {
+		// It is invalid to use %w other than with an Error or with a
+		// non-error argument.
+		_, ok := p.arg.(error)
+		if !ok || !p.wrapErrs {
+			p.badVerb(verb)
+			return true
+		}
+		// If the arg is a Formatter, pass 'v' as the verb to it.
+		verb = 'v'
+	}
+
 	// Is it a Formatter?
 	if formatter, ok := p.arg.(format.Formatter); ok {
 		handled = true
@@ -628,6 +650,12 @@ func (p *printer) handleMethods(verb rune) (handled bool) {
 			// setting handled and deferring catchPanic
 			// must happen before calling the method.
 			switch v := p.arg.(type) {
+			case *Error:
+				handled = true
+				defer p.catchPanic(p.arg, verb)
+				p.fmtString(v.format(&p.Printer), verb)
+				return
+
 			case error:
 				handled = true
 				defer p.catchPanic(p.arg, verb)
@@ -708,6 +736,8 @@ func (p *printer) printArg(arg interface{}, verb rune) {
 		p.fmtString(f, verb)
 	case []byte:
 		p.fmtBytes(f, verb, "[]byte")
+	case time.Time:
+		p.fmtTime(f, verb)
 	case reflect.Value:
 		// Handle extractable values with special methods
 		// since printValue does not handle them at depth 0.
@@ -728,6 +758,17 @@ func (p *printer) printArg(arg interface{}, verb rune) {
 	}
 }
 
+// fmtTime formats t as a localized date and time for the verbs %v and %s.
+// Other verbs and the %+v and %#v variants use the methods of time.Time.
+func (p *printer) fmtTime(t time.Time, verb rune) {
+	if (verb == 'v' || verb == 's') && !p.fmt.PlusV && !p.fmt.SharpV {
+		p.arg = date.DateTime(t, date.Medium, date.Medium)
+	}
+	if !p.handleMethods(verb) {
+		p.printValue(reflect.ValueOf(t), verb, 0)
+	}
+}
+
 // printValue is similar to printArg but starts with a reflect value, not an interface{} value.
 // It does not handle 'p' and 'T' verbs because these should have been already handled by printArg.
 func (p *printer) printValue(value reflect.Value, verb rune, depth int) {
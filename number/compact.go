@@ -0,0 +1,199 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A compactStyle selects the compact decimal patterns of a language.
+type compactStyle uint8
+
+const (
+	notCompact compactStyle = iota
+	compactShort
+	compactLong
+)
+
+const (
+	numCompactStyles = 2
+	maxCompactExp    = 14
+)
+
+// Compact formats a number in a short compact form, such as "1.2K" in English
+// or "1,2 Mio." in German. Numbers that are too small to be abbreviated are
+// formatted as decimals.
+//
+// By default, abbreviated numbers are rounded to two significant digits or to
+// an integer, whichever is more precise, such as "1.2K" and "123K". Rounding
+// options, such as MaxFractionDigits and Precision, apply to the abbreviated
+// number.
+func Compact(x interface{}, opts ...Option) Formatter {
+	return newFormatter(compactOptions, opts, x)
+}
+
+var compactOptions = newCompactOptions(compactShort)
+
+// CompactLong formats a number in a long compact form, such as "1.2 thousand"
+// in English or "1,2 Millionen" in German. It is otherwise like Compact.
+func CompactLong(x interface{}, opts ...Option) Formatter {
+	return newFormatter(compactLongOptions, opts, x)
+}
+
+var compactLongOptions = newCompactOptions(compactLong)
+
+func newCompactOptions(style compactStyle) *options {
+	o := newOptions(decimalVerbs, (*number.Formatter).InitDecimal)
+	o.compact = style
+	return o
+}
+
+// appendCompact appends d formatted in the given compact style. The rounding
+// of f is used if hasRounding is true; otherwise, the default compact rounding
+// applies.
+func appendCompact(dst []byte, t language.Tag, style compactStyle, d *number.Decimal, f *number.Formatter, hasRounding bool) []byte {
+	if d.NaN || d.Inf || len(d.Digits) == 0 {
+		return f.Format(dst, d)
+	}
+	patterns := lookupCompact(t, style)
+	for exp := int(d.Exp) - 1; exp >= 3; exp++ {
+		e := exp
+		if e > maxCompactExp {
+			e = maxCompactExp
+		}
+		pattern := patterns[e]
+		zeros := strings.Count(selectForm(pattern, plural.Other), "0")
+		if zeros == 0 || pattern == "0" {
+			break
+		}
+		scaled := *d
+		scaled.Digits = append([]byte(nil), d.Digits...) // rounding modifies digits
+		scaled.Exp -= int32(e - zeros + 1)
+
+		rc := f.RoundingContext
+		if !hasRounding {
+			rc.MaxSignificantDigits = 0
+			rc.MinFractionDigits = 0
+			rc.MaxFractionDigits = 0
+			if zeros == 1 {
+				rc.MaxFractionDigits = 1
+			}
+		}
+		digits := number.FormatDigits(&scaled, rc)
+		if int(digits.Exp) > zeros && e == exp {
+			continue // Rounding resulted in a number of the next magnitude.
+		}
+
+		form := plural.Cardinal.MatchDigits(t, digits.Digits, int(digits.Exp), digits.NumFracDigits())
+		p := selectForm(pattern, form)
+		if !strings.Contains(p, "0") {
+			start := len(dst)
+			dst = append(dst, p...) // such as "mille" in Italian
+			if f.Parts != nil {
+				*f.Parts = appendPart(*f.Parts, number.CompactPart, start, len(dst))
+			}
+			return dst
+		}
+		pat, err := parseCompactPattern(p)
+		if err != nil {
+			break
+		}
+		cf := *f
+		cf.RoundingContext = rc
+		cf.Affix = pat.Affix
+		cf.Offset = pat.Offset
+		cf.NegOffset = pat.NegOffset
+		cf.GroupingSize = pat.GroupingSize
+		if cf.Parts == nil {
+			return cf.Render(dst, digits)
+		}
+		n := len(*cf.Parts)
+		dst = cf.Render(dst, digits)
+		markCompact(cf.Parts, n, dst)
+		return dst
+	}
+	return f.Format(dst, d)
+}
+
+var compactPatternCache sync.Map // map[string]*number.Pattern
+
+func parseCompactPattern(s string) (*number.Pattern, error) {
+	if p, ok := compactPatternCache.Load(s); ok {
+		return p.(*number.Pattern), nil
+	}
+	p, err := number.ParsePattern(s)
+	if err != nil {
+		return nil, err
+	}
+	compactPatternCache.Store(s, p)
+	return p, nil
+}
+
+type compactKey struct {
+	tag   language.Tag
+	style compactStyle
+}
+
+var compactCache sync.Map // map[compactKey]*[maxCompactExp + 1]string
+
+// lookupCompact returns the compact patterns for the given language and
+// style, resolved against the parents of the language.
+func lookupCompact(t language.Tag, style compactStyle) *[maxCompactExp + 1]string {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	if p, ok := compactCache.Load(compactKey{key, style}); ok {
+		return p.(*[maxCompactExp + 1]string)
+	}
+	var chain []*[numCompactStyles][maxCompactExp + 1]string
+	for p := key; ; p = p.Parent() {
+		if d, ok := compactData[p.String()]; ok {
+			chain = append(chain, d)
+		}
+		if p.IsRoot() {
+			break
+		}
+	}
+	patterns := &[maxCompactExp + 1]string{}
+	for e := range patterns {
+		for i := int(style) - 1; i >= 0 && patterns[e] == ""; i-- {
+			for _, d := range chain {
+				if p := d[i][e]; p != "" {
+					patterns[e] = p
+					break
+				}
+			}
+		}
+	}
+	compactCache.Store(compactKey{key, style}, patterns)
+	return patterns
+}
+
+// selectForm selects the pattern for the given plural form from a list of
+// patterns of the form "one=0 Million|other=0 Millionen", falling back to the
+// pattern for other. A pattern without forms is returned as is.
+func selectForm(patterns string, form plural.Form) string {
+	if !strings.Contains(patterns, "=") {
+		return patterns
+	}
+	other := ""
+	for _, s := range strings.Split(patterns, "|") {
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			continue
+		}
+		switch f := pluralForms[s[:i]]; f {
+		case form:
+			return s[i+1:]
+		case plural.Other:
+			other = s[i+1:]
+		}
+	}
+	return other
+}
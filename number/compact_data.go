@@ -0,0 +1,278 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+// compactData holds the CLDR compact decimal patterns per language for the
+// "latn" numbering system, derived from the CLDR 32 "decimalFormats-numberSystem-latn"
+// short and long formats. The patterns are indexed by the decimal exponent of
+// the number, from 3 (thousands) up to 14.
+//
+// Patterns that depend on the plural form of the displayed number are written
+// as "one=0 Million|other=0 Millionen". A pattern of "0" indicates that numbers
+// of that magnitude are not abbreviated. Missing patterns are inherited from
+// the parent language; missing long patterns fall back to short ones.
+var compactData = map[string]*[numCompactStyles][maxCompactExp + 1]string{
+	"und": {
+		{
+			3:  "0K",
+			4:  "00K",
+			5:  "000K",
+			6:  "0M",
+			7:  "00M",
+			8:  "000M",
+			9:  "0G",
+			10: "00G",
+			11: "000G",
+			12: "0T",
+			13: "00T",
+			14: "000T",
+		},
+		{},
+	},
+	"en": {
+		{
+			3:  "0K",
+			4:  "00K",
+			5:  "000K",
+			6:  "0M",
+			7:  "00M",
+			8:  "000M",
+			9:  "0B",
+			10: "00B",
+			11: "000B",
+			12: "0T",
+			13: "00T",
+			14: "000T",
+		},
+		{
+			3:  "0 thousand",
+			4:  "00 thousand",
+			5:  "000 thousand",
+			6:  "0 million",
+			7:  "00 million",
+			8:  "000 million",
+			9:  "0 billion",
+			10: "00 billion",
+			11: "000 billion",
+			12: "0 trillion",
+			13: "00 trillion",
+			14: "000 trillion",
+		},
+	},
+	"de": {
+		{
+			3:  "0",
+			4:  "0",
+			5:  "0",
+			6:  "0\u00a0Mio'.'",
+			7:  "00\u00a0Mio'.'",
+			8:  "000\u00a0Mio'.'",
+			9:  "0\u00a0Mrd'.'",
+			10: "00\u00a0Mrd'.'",
+			11: "000\u00a0Mrd'.'",
+			12: "0\u00a0Bio'.'",
+			13: "00\u00a0Bio'.'",
+			14: "000\u00a0Bio'.'",
+		},
+		{
+			3:  "0 Tausend",
+			4:  "00 Tausend",
+			5:  "000 Tausend",
+			6:  "one=0 Million|other=0 Millionen",
+			7:  "one=00 Million|other=00 Millionen",
+			8:  "one=000 Million|other=000 Millionen",
+			9:  "one=0 Milliarde|other=0 Milliarden",
+			10: "one=00 Milliarde|other=00 Milliarden",
+			11: "one=000 Milliarde|other=000 Milliarden",
+			12: "one=0 Billion|other=0 Billionen",
+			13: "one=00 Billion|other=00 Billionen",
+			14: "one=000 Billion|other=000 Billionen",
+		},
+	},
+	"es": {
+		{
+			3:  "0\u00a0mil",
+			4:  "00\u00a0mil",
+			5:  "000\u00a0mil",
+			6:  "0\u00a0M",
+			7:  "00\u00a0M",
+			8:  "000\u00a0M",
+			9:  "0000\u00a0M",
+			10: "0\u00a0mil\u00a0M",
+			11: "00\u00a0mil\u00a0M",
+			12: "0\u00a0B",
+			13: "00\u00a0B",
+			14: "000\u00a0B",
+		},
+		{
+			3:  "0 mil",
+			4:  "00 mil",
+			5:  "000 mil",
+			6:  "one=0 millón|other=0 millones",
+			7:  "one=00 millón|other=00 millones",
+			8:  "one=000 millón|other=000 millones",
+			9:  "one=0000 millón|other=0000 millones",
+			10: "0 mil millones",
+			11: "00 mil millones",
+			12: "one=0 billón|other=0 billones",
+			13: "one=00 billón|other=00 billones",
+			14: "one=000 billón|other=000 billones",
+		},
+	},
+	"fr": {
+		{
+			3:  "0\u00a0k",
+			4:  "00\u00a0k",
+			5:  "000\u00a0k",
+			6:  "0\u00a0M",
+			7:  "00\u00a0M",
+			8:  "000\u00a0M",
+			9:  "0\u00a0Md",
+			10: "00\u00a0Md",
+			11: "000\u00a0Md",
+			12: "0\u00a0Bn",
+			13: "00\u00a0Bn",
+			14: "000\u00a0Bn",
+		},
+		{
+			3:  "one=0 millier|other=0 mille",
+			4:  "one=00 millier|other=00 mille",
+			5:  "one=000 millier|other=000 mille",
+			6:  "one=0 million|other=0 millions",
+			7:  "one=00 million|other=00 millions",
+			8:  "one=000 million|other=000 millions",
+			9:  "one=0 milliard|other=0 milliards",
+			10: "one=00 milliard|other=00 milliards",
+			11: "one=000 milliard|other=000 milliards",
+			12: "one=0 billion|other=0 billions",
+			13: "one=00 billion|other=00 billions",
+			14: "one=000 billion|other=000 billions",
+		},
+	},
+	"it": {
+		{
+			3:  "0",
+			4:  "0",
+			5:  "0",
+			6:  "0\u00a0Mln",
+			7:  "00\u00a0Mln",
+			8:  "000\u00a0Mln",
+			9:  "0\u00a0Mrd",
+			10: "00\u00a0Mrd",
+			11: "000\u00a0Mrd",
+			12: "0\u00a0Bln",
+			13: "00\u00a0Bln",
+			14: "000\u00a0Bln",
+		},
+		{
+			3:  "one=mille|other=0 mila",
+			4:  "one=mille|other=00 mila",
+			5:  "one=mille|other=000 mila",
+			6:  "one=0 milione|other=0 milioni",
+			7:  "one=00 milione|other=00 milioni",
+			8:  "one=000 milione|other=000 milioni",
+			9:  "one=0 miliardo|other=0 miliardi",
+			10: "one=00 miliardo|other=00 miliardi",
+			11: "one=000 miliardo|other=000 miliardi",
+			12: "one=0 mille miliardi|other=0 mila miliardi",
+			13: "one=00 mille miliardi|other=00 mila miliardi",
+			14: "one=000 mille miliardi|other=000 mila miliardi",
+		},
+	},
+	"ja": {
+		{
+			3:  "0",
+			4:  "0万",
+			5:  "00万",
+			6:  "000万",
+			7:  "0000万",
+			8:  "0億",
+			9:  "00億",
+			10: "000億",
+			11: "0000億",
+			12: "0兆",
+			13: "00兆",
+			14: "000兆",
+		},
+		{},
+	},
+	"nl": {
+		{
+			3:  "0K",
+			4:  "00K",
+			5:  "000K",
+			6:  "0\u00a0mln'.'",
+			7:  "00\u00a0mln'.'",
+			8:  "000\u00a0mln'.'",
+			9:  "0\u00a0mld'.'",
+			10: "00\u00a0mld'.'",
+			11: "000\u00a0mld'.'",
+			12: "0\u00a0bln'.'",
+			13: "00\u00a0bln'.'",
+			14: "000\u00a0bln'.'",
+		},
+		{
+			3:  "0 duizend",
+			4:  "00 duizend",
+			5:  "000 duizend",
+			6:  "0 miljoen",
+			7:  "00 miljoen",
+			8:  "000 miljoen",
+			9:  "0 miljard",
+			10: "00 miljard",
+			11: "000 miljard",
+			12: "0 biljoen",
+			13: "00 biljoen",
+			14: "000 biljoen",
+		},
+	},
+	"ru": {
+		{
+			3:  "0\u00a0тыс'.'",
+			4:  "00\u00a0тыс'.'",
+			5:  "000\u00a0тыс'.'",
+			6:  "0\u00a0млн",
+			7:  "00\u00a0млн",
+			8:  "000\u00a0млн",
+			9:  "0\u00a0млрд",
+			10: "00\u00a0млрд",
+			11: "000\u00a0млрд",
+			12: "0\u00a0трлн",
+			13: "00\u00a0трлн",
+			14: "000\u00a0трлн",
+		},
+		{
+			3:  "one=0 тысяча|few=0 тысячи|many=0 тысяч|other=0 тысячи",
+			4:  "one=00 тысяча|few=00 тысячи|many=00 тысяч|other=00 тысячи",
+			5:  "one=000 тысяча|few=000 тысячи|many=000 тысяч|other=000 тысячи",
+			6:  "one=0 миллион|few=0 миллиона|many=0 миллионов|other=0 миллиона",
+			7:  "one=00 миллион|few=00 миллиона|many=00 миллионов|other=00 миллиона",
+			8:  "one=000 миллион|few=000 миллиона|many=000 миллионов|other=000 миллиона",
+			9:  "one=0 миллиард|few=0 миллиарда|many=0 миллиардов|other=0 миллиарда",
+			10: "one=00 миллиард|few=00 миллиарда|many=00 миллиардов|other=00 миллиарда",
+			11: "one=000 миллиард|few=000 миллиарда|many=000 миллиардов|other=000 миллиарда",
+			12: "one=0 триллион|few=0 триллиона|many=0 триллионов|other=0 триллиона",
+			13: "one=00 триллион|few=00 триллиона|many=00 триллионов|other=00 триллиона",
+			14: "one=000 триллион|few=000 триллиона|many=000 триллионов|other=000 триллиона",
+		},
+	},
+	"zh": {
+		{
+			3:  "0",
+			4:  "0万",
+			5:  "00万",
+			6:  "000万",
+			7:  "0000万",
+			8:  "0亿",
+			9:  "00亿",
+			10: "000亿",
+			11: "0000亿",
+			12: "0万亿",
+			13: "00万亿",
+			14: "000万亿",
+		},
+		{},
+	},
+}
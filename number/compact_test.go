@@ -0,0 +1,137 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestCompact(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", Compact(0), "0"},
+		{"en", Compact(999), "999"},
+		{"en", Compact(1000), "1K"},
+		{"en", Compact(1234), "1.2K"},
+		{"en", Compact(12345), "12K"},
+		{"en", Compact(123456), "123K"},
+		{"en", Compact(999999), "1M"},
+		{"en", Compact(1500000), "1.5M"},
+		{"en", Compact(1234567890), "1.2B"},
+		{"en", Compact(2e12), "2T"},
+		{"en", Compact(1.5e16), "15000T"},
+		{"en", Compact(-4321), "-4.3K"},
+		{"en", Compact(0.5), "0.5"},
+		{"en", Compact(math.Inf(1)), "∞"},
+		{"en", Compact(12346, MaxFractionDigits(2)), "12.35K"},
+		{"en", Compact(1234, MaxFractionDigits(0)), "1K"},
+		{"en", Compact(1234, Precision(3)), "1.23K"},
+		{"en", CompactLong(1000), "1 thousand"},
+		{"en", CompactLong(1234567), "1.2 million"},
+		{"en-GB", CompactLong(2e9), "2 billion"},
+
+		{"de", Compact(1234), "1.234"},
+		{"de", Compact(999999), "999.999"},
+		{"de", Compact(1500000), "1,5\u00a0Mio."},
+		{"de", Compact(1234567890), "1,2\u00a0Mrd."},
+		{"de", CompactLong(1000000), "1 Million"},
+		{"de", CompactLong(2000000), "2 Millionen"},
+		{"de", CompactLong(1234), "1,2 Tausend"},
+		{"de-CH", Compact(3e6), "3\u00a0Mio."},
+
+		{"fr", Compact(1234), "1,2\u00a0k"},
+		{"fr", Compact(2e9), "2\u00a0Md"},
+		{"fr", CompactLong(1000000), "1 million"},
+		{"fr", CompactLong(21000000), "21 millions"},
+
+		{"es", Compact(1000), "1\u00a0mil"},
+		{"es", CompactLong(1e6), "1 millón"},
+		{"es", CompactLong(1.5e6), "1,5 millones"},
+
+		{"it", Compact(1234), "1.234"},
+		{"it", Compact(1e6), "1\u00a0Mln"},
+		{"it", CompactLong(1000), "mille"},
+		{"it", CompactLong(2000), "2 mila"},
+
+		{"ja", Compact(1234), "1,234"},
+		{"ja", Compact(12345), "1.2万"},
+		{"ja", Compact(1234567890), "12億"},
+		{"zh", Compact(2e12), "2万亿"},
+
+		{"ru", CompactLong(1000), "1 тысяча"},
+		{"ru", CompactLong(3000), "3 тысячи"},
+		{"ru", CompactLong(5000), "5 тысяч"},
+		{"ru", CompactLong(1500), "1,5 тысячи"},
+		{"ru", Compact(2e6), "2\u00a0млн"},
+
+		{"nl", Compact(1234), "1,2K"},
+		{"nl", CompactLong(2e6), "2 miljoen"},
+
+		// Languages without long patterns use the short ones.
+		{"ko", CompactLong(1234), "1.2K"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			p := message.NewPrinter(language.MustParse(tc.tag))
+			if got := p.Sprint(tc.f); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompactData(t *testing.T) {
+	for id, d := range compactData {
+		for style, patterns := range d {
+			for e, s := range patterns {
+				if s == "" || s == "0" {
+					continue
+				}
+				for _, p := range strings.Split(s, "|") {
+					if i := strings.IndexByte(p, '='); i >= 0 {
+						if _, ok := pluralForms[p[:i]]; !ok {
+							t.Errorf("%s/%d/%d: unknown plural form in %q", id, style, e, p)
+						}
+						p = p[i+1:]
+					}
+					if !strings.Contains(p, "0") {
+						continue
+					}
+					if _, err := parseCompactPattern(p); err != nil {
+						t.Errorf("%s/%d/%d: %v", id, style, e, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSelectForm(t *testing.T) {
+	testCases := []struct {
+		patterns string
+		form     plural.Form
+		want     string
+	}{
+		{"0K", plural.One, "0K"},
+		{"one=0 Million|other=0 Millionen", plural.One, "0 Million"},
+		{"one=0 Million|other=0 Millionen", plural.Other, "0 Millionen"},
+		{"one=0 Million|other=0 Millionen", plural.Few, "0 Millionen"},
+		{"one=mille|other=0 mila", plural.One, "mille"},
+	}
+	for _, tc := range testCases {
+		if got := selectForm(tc.patterns, tc.form); got != tc.want {
+			t.Errorf("selectForm(%q, %v) = %q; want %q", tc.patterns, tc.form, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A Compiled formats numbers with a fixed format and set of options. It
+// retains the formatting information for each language it is used with, so
+// that formatting a number requires little more than converting and rendering
+// its digits. A Compiled is safe for concurrent use.
+//
+// Unlike formatting with a message.Printer, formatting a number with
+// AppendInt, AppendUint, or AppendFloat does not allocate if dst has enough
+// capacity, except for compact and rule-based formats.
+type Compiled struct {
+	options *options
+
+	mu    sync.Mutex   // serializes additions to cache
+	cache atomic.Value // []*compiledFormatter
+}
+
+type compiledFormatter struct {
+	tag         language.Tag
+	f           number.Formatter
+	hasRounding bool
+}
+
+// Compile returns a Compiled that formats numbers like format with the given
+// options added. Options that depend on the number, such as the width and
+// precision of a formatting verb, cannot be set.
+func Compile(format FormatFunc, opts ...Option) *Compiled {
+	o := *format(nil).options
+	n := len(o.options)
+	o.options = append(o.options[:n:n], opts...)
+	return &Compiled{options: &o}
+}
+
+// decimalPool holds Decimals for reuse. A Decimal refers to its own buffer and
+// therefore does not stay on the stack.
+var decimalPool = sync.Pool{
+	New: func() interface{} { return new(number.Decimal) },
+}
+
+// lookup returns the formatter for language t, creating it if needed.
+func (c *Compiled) lookup(t language.Tag) *compiledFormatter {
+	list, _ := c.cache.Load().([]*compiledFormatter)
+	for _, e := range list {
+		if e.tag == t {
+			return e
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	list, _ = c.cache.Load().([]*compiledFormatter)
+	for _, e := range list {
+		if e.tag == t {
+			return e
+		}
+	}
+	p, rounding := c.options.formatter(t)
+	rounding.Mode = p.Mode
+	e := &compiledFormatter{tag: t, f: p, hasRounding: p.RoundingContext != rounding}
+	c.cache.Store(append(list[:len(list):len(list)], e))
+	return e
+}
+
+// AppendFormat appends x formatted for language t to dst and returns the
+// extended buffer. The value x may be of any type supported by Decimal.
+//
+// Converting an integer or floating-point value to an interface value may
+// allocate. Use AppendInt, AppendUint, or AppendFloat to avoid this.
+func (c *Compiled) AppendFormat(dst []byte, t language.Tag, x interface{}) []byte {
+	e := c.lookup(t)
+	p := e.f
+	d := decimalPool.Get().(*number.Decimal)
+	d.Convert(p.RoundingContext, x)
+	dst = c.options.render(dst, nil, t, &p, d, e.hasRounding)
+	decimalPool.Put(d)
+	return dst
+}
+
+// AppendInt appends the integer x formatted for language t to dst and returns
+// the extended buffer.
+func (c *Compiled) AppendInt(dst []byte, t language.Tag, x int64) []byte {
+	e := c.lookup(t)
+	p := e.f
+	d := decimalPool.Get().(*number.Decimal)
+	d.ConvertInt(p.RoundingContext, true, uint64(x))
+	dst = c.options.render(dst, nil, t, &p, d, e.hasRounding)
+	decimalPool.Put(d)
+	return dst
+}
+
+// AppendUint appends the unsigned integer x formatted for language t to dst
+// and returns the extended buffer.
+func (c *Compiled) AppendUint(dst []byte, t language.Tag, x uint64) []byte {
+	e := c.lookup(t)
+	p := e.f
+	d := decimalPool.Get().(*number.Decimal)
+	d.ConvertInt(p.RoundingContext, false, x)
+	dst = c.options.render(dst, nil, t, &p, d, e.hasRounding)
+	decimalPool.Put(d)
+	return dst
+}
+
+// AppendFloat appends the floating-point number x formatted for language t to
+// dst and returns the extended buffer.
+func (c *Compiled) AppendFloat(dst []byte, t language.Tag, x float64) []byte {
+	e := c.lookup(t)
+	p := e.f
+	d := decimalPool.Get().(*number.Decimal)
+	d.ConvertFloat(p.RoundingContext, x, 64)
+	dst = c.options.render(dst, nil, t, &p, d, e.hasRounding)
+	decimalPool.Put(d)
+	return dst
+}
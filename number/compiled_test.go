@@ -0,0 +1,188 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestCompiled(t *testing.T) {
+	testCases := []struct {
+		tag    string
+		format FormatFunc
+		opts   []Option
+		x      interface{}
+	}{
+		{"en", Decimal, nil, 1234.5},
+		{"en", Decimal, nil, -1234},
+		{"de", Decimal, []Option{Scale(2)}, 1234.5},
+		{"de", Percent, nil, 0.25},
+		{"en", Scientific, nil, 1234},
+		{"ar", Decimal, nil, 1234},
+		{"en", Compact, nil, 1234567},
+		{"en", Compact, []Option{MaxFractionDigits(2)}, 1234567},
+		{"fr", SpellOut, nil, 21},
+		{"en", Decimal, []Option{MaxSignificantDigits(2)}, "1234.5"},
+		{"en", Decimal, nil, math.Inf(-1)},
+		{"en", Approximately, nil, 5},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.tag, "/", tc.x), func(t *testing.T) {
+			tag := language.MustParse(tc.tag)
+			want := message.NewPrinter(tag).Sprint(tc.format(tc.x, tc.opts...))
+			c := Compile(tc.format, tc.opts...)
+			if got := string(c.AppendFormat([]byte("x"), tag, tc.x)); got != "x"+want {
+				t.Errorf("AppendFormat: got %q; want %q", got, "x"+want)
+			}
+			// Use the cached formatter.
+			if got := string(c.AppendFormat(nil, tag, tc.x)); got != want {
+				t.Errorf("AppendFormat: got %q; want %q", got, want)
+			}
+			var got []byte
+			switch x := tc.x.(type) {
+			case int:
+				got = c.AppendInt(nil, tag, int64(x))
+				if x >= 0 {
+					if got := string(c.AppendUint(nil, tag, uint64(x))); got != want {
+						t.Errorf("AppendUint: got %q; want %q", got, want)
+					}
+				}
+			case float64:
+				got = c.AppendFloat(nil, tag, x)
+			default:
+				return
+			}
+			if string(got) != want {
+				t.Errorf("got %q; want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCompiledConcurrent(t *testing.T) {
+	c := Compile(Decimal)
+	tags := []language.Tag{language.English, language.German, language.French, language.Japanese}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tag := tags[(i+j)%len(tags)]
+				got := string(c.AppendInt(nil, tag, 1234567))
+				want := message.NewPrinter(tag).Sprint(Decimal(1234567))
+				if got != want {
+					t.Errorf("%v: got %q; want %q", tag, got, want)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestCompiledAllocs(t *testing.T) {
+	testCases := []struct {
+		desc string
+		f    func(c *Compiled, buf []byte) []byte
+	}{
+		{"AppendInt", func(c *Compiled, buf []byte) []byte {
+			return c.AppendInt(buf, language.German, -1234567)
+		}},
+		{"AppendUint", func(c *Compiled, buf []byte) []byte {
+			return c.AppendUint(buf, language.German, 1234567)
+		}},
+		{"AppendFloat", func(c *Compiled, buf []byte) []byte {
+			return c.AppendFloat(buf, language.German, 1234.5678)
+		}},
+	}
+	for _, format := range []FormatFunc{Decimal, Percent, Scientific} {
+		c := Compile(format, MaxFractionDigits(2))
+		buf := make([]byte, 0, 64)
+		for _, tc := range testCases {
+			tc.f(c, buf) // Fill the cache.
+			if n := testing.AllocsPerRun(100, func() { tc.f(c, buf) }); n > 0 {
+				t.Errorf("%s: got %v allocs; want 0", tc.desc, n)
+			}
+		}
+	}
+}
+
+func TestFmtFormatter(t *testing.T) {
+	testCases := []struct {
+		fmt  string
+		f    interface{}
+		want string
+	}{
+		{"%v", Decimal(1234.5), "1,234.5"},
+		{"%.2f", Decimal(1234.5), "1,234.50"},
+		{"%v", Percent(0.25), "25%"},
+		{"%v", Scientific(1234), "1.234×10³"},
+		{"%v", Range(3, 5), "3–5"},
+		{"%x", Decimal(12), "%!x(int=12)"},
+	}
+	for _, tc := range testCases {
+		if got := fmt.Sprintf(tc.fmt, tc.f); got != tc.want {
+			t.Errorf("Sprintf(%q, %v): got %q; want %q", tc.fmt, tc.f, got, tc.want)
+		}
+	}
+}
+
+func BenchmarkPrinterDecimal(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		p := message.NewPrinter(language.English)
+		for pb.Next() {
+			p.Sprint(Decimal(1234.5678))
+		}
+	})
+}
+
+func BenchmarkFmtDecimal(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = fmt.Sprint(Decimal(1234.5678))
+		}
+	})
+}
+
+func BenchmarkCompiledAppendFormat(b *testing.B) {
+	c := Compile(Decimal)
+	var x interface{} = 1234.5678
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		buf := make([]byte, 0, 64)
+		for pb.Next() {
+			c.AppendFormat(buf, language.English, x)
+		}
+	})
+}
+
+func BenchmarkCompiledAppendInt(b *testing.B) {
+	c := Compile(Decimal)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		buf := make([]byte, 0, 64)
+		for pb.Next() {
+			c.AppendInt(buf, language.English, 1234567)
+		}
+	})
+}
+
+func BenchmarkCompiledAppendFloat(b *testing.B) {
+	c := Compile(Decimal)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		buf := make([]byte, 0, 64)
+		for pb.Next() {
+			c.AppendFloat(buf, language.English, 1234.5678)
+		}
+	})
+}
@@ -6,8 +6,11 @@
 //
 // The number formats of this package allow for greater formatting flexibility
 // than passing values to message.Printf calls as is. It currently supports the
-// builtin Go types and anything that implements the Convert interface
-// (currently internal).
+// builtin Go types, the arbitrary-precision types *big.Int, *big.Float, and
+// *big.Rat, decimal strings, such as "1234.5678" or "1.5e-7", and anything that
+// implements the Convert interface (currently internal). Arbitrary-precision
+// values and decimal strings are formatted exactly: they are never converted to
+// a float64.
 //
 //	p := message.NewPrinter(language.English)
 //
@@ -21,6 +24,61 @@
 //	p.Printf("There are %v bikes per household.\n", number.Decimal(1.2))
 //	// Prints: There are 1,2 bikes per household.
 //
+// Large numbers can be abbreviated with Compact and CompactLong:
+//
+//	p.Printf("%v downloads\n", number.Compact(1234567))
+//	// Prints: 1,2 mln. downloads
+//
+// Numbers can also be spelled out, written as ordinals, or written in an
+// algorithmic numbering system, such as Roman numerals, using the CLDR
+// rule-based number formats:
+//
+//	p = message.NewPrinter(language.English)
+//	p.Printf("Pay %v dollars.\n", number.SpellOut(123))
+//	// Prints: Pay one hundred twenty-three dollars.
+//
+//	p.Printf("You finished %v.\n", number.Ordinal(2))
+//	// Prints: You finished 2nd.
+//
+// The digits of a number are those of the numbering system of the language,
+// which can be changed with the "nu" Unicode extension key or the
+// NumberingSystem option. Algorithmic numbering systems, such as traditional
+// Chinese numerals, are supported as well:
+//
+//	p = message.NewPrinter(language.MustParse("zh-TW-u-nu-traditio"))
+//	p.Printf("%v\n", number.Decimal(1234))
+//	// Prints: 一千二百三十四
+//
+// Ranges and approximations are formatted using the conventions of the
+// language as well:
+//
+//	p.Printf("%v kg\n", number.Range(3, 5))
+//	// Prints: 3–5 kg
+//
+//	p.Printf("%v\n", number.AtLeast(100))
+//	// Prints: 100+
+//
 // The width and scale specified in the formatting directives override the
 // configuration of the formatter.
+//
+// Formatters can also be used with package fmt, in which case numbers are
+// formatted for the root language. A Compiled formatter appends numbers to a
+// buffer without the overhead of a message.Printer:
+//
+//	c := number.Compile(number.Decimal, number.MaxFractionDigits(2))
+//	buf = c.AppendFloat(buf[:0], language.German, 1234.5678) // "1.234,57"
+//
+// FormatToParts breaks a formatted number down into its parts, such as its
+// integer digits, group separators, and signs, for styled rendering:
+//
+//	s, parts := number.Decimal(1234.5).FormatToParts(language.English)
+//	// s == "1,234.5"; parts[1] == number.Part{number.GroupPart, 1, 2}
+//
+// Parse and ParseDecimal convert numbers formatted for a language back to
+// their value:
+//
+//	x, err := number.Parse(language.German, "1.234,5") // x == 1234.5
+//
+// The strings returned by ParseDecimal can be formatted without loss of
+// precision.
 package number
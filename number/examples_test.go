@@ -5,11 +5,28 @@
 package number_test
 
 import (
+	"fmt"
+	"math/big"
+
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
 	"golang.org/x/text/number"
 )
 
+func ExampleDecimal_bigNumbers() {
+	p := message.NewPrinter(language.German)
+
+	n, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	p.Println(number.Decimal(n))
+	p.Println(number.Decimal(big.NewRat(2, 3), number.Scale(2)))
+	p.Println(number.Decimal("12345678901234567.89"))
+
+	// Output:
+	// 123.456.789.012.345.678.901.234.567.890
+	// 0,67
+	// 12.345.678.901.234.567,89
+}
+
 func ExampleMaxIntegerDigits() {
 	const year = 1999
 	p := message.NewPrinter(language.English)
@@ -26,3 +43,190 @@ func ExampleIncrementString() {
 
 	// Output: 1.50
 }
+
+func ExampleRoundingMode() {
+	p := message.NewPrinter(language.English)
+	for _, mode := range []number.Rounding{number.ToNearestEven, number.ToNearestAway, number.ToZero} {
+		p.Println(mode, number.Decimal(2.5, number.Scale(0), number.RoundingMode(mode)))
+	}
+
+	// Output:
+	// ToNearestEven 2
+	// ToNearestAway 3
+	// ToZero 2
+}
+
+func ExampleRange() {
+	p := message.NewPrinter(language.English)
+	p.Println(number.Range(3, 5))
+	p.Println(number.Range(number.Percent(0.03), number.Percent(0.05)))
+	p.Println(number.Range(-5, -3))
+	p.Println(number.Range(4.99, 5.01, number.MaxFractionDigits(0)))
+
+	p = message.NewPrinter(language.German)
+	p.Println(number.Range(1.5, 2.5))
+	p.Println(number.Approximately(5))
+
+	// Output:
+	// 3–5
+	// 3–5%
+	// -5 – -3
+	// ~5
+	// 1,5–2,5
+	// ≈5
+}
+
+func ExampleMaxSignificantDigits() {
+	p := message.NewPrinter(language.English)
+	p.Println(number.Decimal(1234.5678, number.MaxSignificantDigits(3)))
+	p.Println(number.Decimal(0.012345, number.MaxSignificantDigits(3)))
+	p.Println(number.Decimal(0.012345, number.MaxSignificantDigits(3),
+		number.MaxFractionDigits(2)))
+	p.Println(number.Decimal(0.012345, number.MaxSignificantDigits(3),
+		number.MaxFractionDigits(2), number.RoundingPriority(number.MorePrecision)))
+
+	// Output:
+	// 1,230
+	// 0.0123
+	// 0.01
+	// 0.0123
+}
+
+func ExampleCompact() {
+	p := message.NewPrinter(language.English)
+	p.Println(number.Compact(1234), number.Compact(1234567))
+	p.Println(number.CompactLong(1234567))
+
+	p = message.NewPrinter(language.German)
+	p.Println(number.CompactLong(1234567))
+
+	p = message.NewPrinter(language.Japanese)
+	p.Println(number.Compact(1234), number.Compact(1234567))
+
+	// Output:
+	// 1.2K 1.2M
+	// 1.2 million
+	// 1,2 Millionen
+	// 1,234 123万
+}
+
+func ExampleSpellOut() {
+	p := message.NewPrinter(language.English)
+	p.Println(number.SpellOut(123))
+	p.Println(number.SpellOut(-2.5))
+
+	p = message.NewPrinter(language.French)
+	p.Println(number.SpellOut(80))
+
+	// Output:
+	// one hundred twenty-three
+	// minus two point five
+	// quatre-vingts
+}
+
+func ExampleOrdinal() {
+	for _, tag := range []string{"en", "fr", "de"} {
+		p := message.NewPrinter(language.MustParse(tag))
+		p.Println(number.Ordinal(1), number.Ordinal(2), number.Ordinal(3))
+	}
+
+	// Output:
+	// 1st 2nd 3rd
+	// 1er 2e 3e
+	// 1. 2. 3.
+}
+
+func ExampleNumbering() {
+	p := message.NewPrinter(language.English)
+	p.Printf("Super Bowl %v\n", number.Numbering(50))
+
+	p = message.NewPrinter(language.MustParse("en-u-nu-romanlow"))
+	p.Printf("Page %v\n", number.Numbering(14))
+
+	// Output:
+	// Super Bowl L
+	// Page xiv
+}
+
+func ExampleNumberingSystem() {
+	p := message.NewPrinter(language.Arabic)
+	p.Println(number.Decimal(1234))
+	p.Println(number.Decimal(1234, number.NumberingSystem("latn")))
+
+	p = message.NewPrinter(language.Chinese)
+	p.Println(number.Decimal(1234, number.NumberingSystem("native")))
+	p.Println(number.Decimal(1234, number.NumberingSystem("traditio")))
+	p.Println(number.Decimal(1234, number.NumberingSystem("finance")))
+
+	// Output:
+	// ١٬٢٣٤
+	// 1,234
+	// 一,二三四
+	// 一千二百三十四
+	// 壹仟贰佰叁拾肆
+}
+
+func ExampleRuleBased() {
+	spellOrdinal := number.RuleBased("spellout-ordinal")
+
+	p := message.NewPrinter(language.English)
+	p.Printf("the %v floor\n", spellOrdinal(21))
+
+	// Output: the twenty-first floor
+}
+
+func ExampleParse() {
+	x, err := number.Parse(language.German, "1.234,5")
+	fmt.Println(x, err)
+
+	x, err = number.Parse(language.Arabic, "١٢٣")
+	fmt.Println(x, err)
+
+	// A space is not a valid grouping separator in English, unless parsing
+	// leniently.
+	_, err = number.Parse(language.English, "1 234")
+	fmt.Println(err)
+
+	// Output:
+	// 1234.5 <nil>
+	// 123 <nil>
+	// number: invalid number syntax
+}
+
+func ExampleParseDecimal() {
+	d, err := number.ParseDecimal(language.English, " 12.50% ", number.Lenient())
+	fmt.Println(d, err)
+
+	// Output: 0.1250 <nil>
+}
+
+func ExampleFormatter_FormatToParts() {
+	s, parts := number.Decimal(-1234.5).FormatToParts(language.English)
+	for _, p := range parts {
+		fmt.Printf("%-14v %q\n", p.Kind, s[p.Start:p.End])
+	}
+
+	// Output:
+	// MinusSignPart  "-"
+	// IntegerPart    "1"
+	// GroupPart      ","
+	// IntegerPart    "234"
+	// DecimalPart    "."
+	// FractionPart   "5"
+}
+
+func ExampleCompile() {
+	c := number.Compile(number.Decimal, number.MaxFractionDigits(2))
+
+	var buf []byte
+	for _, t := range []language.Tag{language.English, language.German} {
+		buf = c.AppendFloat(buf[:0], t, 1234.5678)
+		fmt.Println(string(buf))
+	}
+	fmt.Printf("%v\n", number.Percent(0.25))
+
+	// Output:
+	// 1,234.57
+	// 1.234,57
+	// 25%
+}
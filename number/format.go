@@ -33,6 +33,16 @@ type options struct {
 	initFunc   initFunc
 	options    []Option
 	pluralFunc func(t language.Tag, scale int) (f plural.Form, n int)
+
+	// ruleSet returns the name of the rule set for rule-based formatting of
+	// numbers in the given language. It is nil for other formats.
+	ruleSet func(t language.Tag) string
+
+	compact compactStyle
+
+	// misc is the miscPattern in which the formatted number is substituted
+	// or noMisc.
+	misc miscPattern
 }
 
 type optionFlag uint16
@@ -56,7 +66,16 @@ func newFormatter(o *options, opts []Option, value interface{}) Formatter {
 }
 
 func newOptions(verbs string, f initFunc) *options {
-	return &options{verbs: verbs, initFunc: f}
+	return &options{verbs: verbs, initFunc: f, misc: noMisc}
+}
+
+func newRuleBasedOptions(ruleSet func(t language.Tag) string) *options {
+	return &options{
+		verbs:    ruleBasedVerbs,
+		initFunc: (*number.Formatter).InitDecimal,
+		ruleSet:  ruleSet,
+		misc:     noMisc,
+	}
 }
 
 type Formatter struct {
@@ -64,31 +83,30 @@ type Formatter struct {
 	value interface{}
 }
 
-// Format implements format.Formatter. It is for internal use only for now.
-func (f Formatter) Format(state format.State, verb rune) {
-	// TODO: consider implementing fmt.Formatter instead and using the following
-	// piece of code. This allows numbers to be rendered mostly as expected
-	// when using fmt. But it may get weird with the spellout options and we
-	// may need more of format.State over time.
-	// lang := language.Und
-	// if s, ok := state.(format.State); ok {
-	// 	lang = s.Language()
-	// }
-
-	lang := state.Language()
+// Format implements fmt.Formatter. It formats the number in the language of
+// state if it implements format.State, and in the root language otherwise.
+func (f Formatter) Format(state fmt.State, verb rune) {
+	lang := language.Und
+	if s, ok := state.(format.State); ok {
+		lang = s.Language()
+	}
 	if !strings.Contains(f.verbs, string(verb)) {
 		fmt.Fprintf(state, "%%!%s(%T=%v)", string(verb), f.value, f.value)
 		return
 	}
-	var p number.Formatter
-	f.initFunc(&p, lang)
-	for _, o := range f.options.options {
-		o(lang, &p)
-	}
-	if w, ok := state.Width(); ok {
+	var buf [64]byte
+	state.Write(f.appendFormat(buf[:0], nil, lang, state, verb))
+}
+
+// appendFormat appends the number of f formatted for language lang to dst.
+// The width and precision of s, if set, override the options of f. If parts
+// is not nil, the parts of the formatted number are appended to it.
+func (f Formatter) appendFormat(dst []byte, parts *[]number.Part, lang language.Tag, s fmt.State, verb rune) []byte {
+	p, rounding := f.formatter(lang)
+	if w, ok := s.Width(); ok {
 		p.FormatWidth = uint16(w)
 	}
-	if prec, ok := state.Precision(); ok {
+	if prec, ok := s.Precision(); ok {
 		switch verb {
 		case 'd':
 			p.SetScale(0)
@@ -100,17 +118,68 @@ func (f Formatter) Format(state format.State, verb rune) {
 			p.SetPrecision(prec)
 		}
 	}
+	rounding.Mode = p.Mode
 	var d number.Decimal
 	d.Convert(p.RoundingContext, f.value)
-	state.Write(p.Format(nil, &d))
+	return f.render(dst, parts, lang, &p, &d, p.RoundingContext != rounding)
+}
+
+// formatter returns the formatter for language t with the options of o
+// applied. It also returns the rounding of the format before the options were
+// applied.
+func (o *options) formatter(t language.Tag) (p number.Formatter, rounding number.RoundingContext) {
+	o.initFunc(&p, t)
+	switch nu := t.TypeForKey("nu"); nu {
+	case "native", "traditio", "finance":
+		setNumberingSystem(t, &p, nu)
+	}
+	rounding = p.RoundingContext
+	for _, opt := range o.options {
+		opt(t, &p)
+	}
+	return p, rounding
+}
+
+// render appends d formatted with p for language lang to dst. The rounding of
+// p overrides the default rounding of compact numbers if hasRounding is true.
+// If parts is not nil, the parts of the formatted number are appended to it.
+func (o *options) render(dst []byte, parts *[]number.Part, lang language.Tag, p *number.Formatter, d *number.Decimal, hasRounding bool) []byte {
+	after := ""
+	if o.misc != noMisc {
+		before, a, ok := strings.Cut(lookupMisc(lang)[o.misc], "{0}")
+		if !ok {
+			before, a = "", ""
+		}
+		dst = appendLiteral(dst, parts, before)
+		after = a
+	}
+	p.Parts = parts
+	switch {
+	case o.ruleSet != nil, !p.IsDecimal():
+		// The output of the rules is not broken down into parts.
+		p.Parts = nil
+		start := len(dst)
+		if o.ruleSet != nil {
+			dst = appendRBNF(dst, lang, o.ruleSet(lang), d, p)
+		} else {
+			dst = appendAlgorithmic(dst, lang, d, p)
+		}
+		if parts != nil {
+			*parts = appendPart(*parts, number.LiteralPart, start, len(dst))
+		}
+	case o.compact != notCompact:
+		dst = appendCompact(dst, lang, o.compact, d, p, hasRounding)
+	default:
+		dst = p.Format(dst, d)
+	}
+	return appendLiteral(dst, parts, after)
 }
 
 // Digits returns information about which logical digits will be presented to
 // the user. This information is relevant, for instance, to determine plural
 // forms.
 func (f Formatter) Digits(buf []byte, tag language.Tag, scale int) number.Digits {
-	var p number.Formatter
-	f.initFunc(&p, tag)
+	p, _ := f.formatter(tag)
 	if scale >= 0 {
 		// TODO: this only works well for decimal numbers, which is generally
 		// fine.
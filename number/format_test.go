@@ -89,6 +89,12 @@ func TestPluralIntegration(t *testing.T) {
 	}, {
 		f:    Decimal(5),
 		want: "other: 5",
+	}, {
+		f:    Decimal(1.5, Scale(0), RoundingMode(ToZero)),
+		want: "one: 1",
+	}, {
+		f:    Decimal(1.5, Scale(0)),
+		want: "other: 2",
 	}}
 	for _, tc := range testCases {
 		t.Run("", func(t *testing.T) {
@@ -14,11 +14,13 @@ package number
 
 import (
 	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
 )
 
 const (
 	decimalVerbs    = "vfgd"
 	scientificVerbs = "veg"
+	ruleBasedVerbs  = "vs"
 )
 
 // Decimal formats a number as a floating point decimal.
@@ -58,20 +60,68 @@ func PerMille(x interface{}, opts ...Option) Formatter {
 
 var perMilleOptions = newOptions(decimalVerbs, (*number.Formatter).InitPerMille)
 
+// SpellOut formats a number spelled out in words, such as "one hundred
+// twenty-three". Numbers with a fractional part are spelled out digit by
+// digit after the decimal separator, such as "one point five". Numbers are
+// formatted as decimals for languages without spell-out rules.
+func SpellOut(x interface{}, opts ...Option) Formatter {
+	return newFormatter(spellOutOptions, opts, x)
+}
+
+var spellOutOptions = newRuleBasedOptions(fixedRuleSet("%spellout-numbering"))
+
+// Ordinal formats a number as an ordinal using digits, such as "1st" in
+// English, "1er" in French, or "1." in German.
+func Ordinal(x interface{}, opts ...Option) Formatter {
+	return newFormatter(ordinalOptions, opts, x)
+}
+
+var ordinalOptions = newRuleBasedOptions(fixedRuleSet("%digits-ordinal"))
+
+// Numbering formats a number using an algorithmic numbering system. The
+// numbering system is selected by the "nu" Unicode extension key of the
+// language or by the NumberingSystem option. Supported systems are "roman",
+// the default, for Roman numerals in upper case, "romanlow" for Roman numerals
+// in lower case, "hans" and "hant" for simplified and traditional Chinese
+// numerals, "hansfin" and "hantfin" for their financial variants, and "jpan"
+// for Japanese numerals. Numbers that cannot be represented in the numbering
+// system, such as Roman numerals from 4000, are formatted as decimals.
+func Numbering(x interface{}, opts ...Option) Formatter {
+	return newFormatter(numberingOptions, opts, x)
+}
+
+var numberingOptions = newOptions(ruleBasedVerbs, func(f *number.Formatter, t language.Tag) {
+	f.InitDecimal(t)
+	if f.IsDecimal() {
+		setNumberingSystem(t, f, "roman")
+	}
+})
+
+// RuleBased returns a FormatFunc that formats numbers using the CLDR
+// rule-based number format with the given name, such as "spellout-ordinal",
+// "spellout-cardinal-feminine", or "roman-lower". Numbers are formatted as
+// decimals for languages that do not define the rule set. See
+// https://unicode.org/reports/tr35/tr35-numbers.html#Rule-Based_Number_Formatting.
+func RuleBased(name string) FormatFunc {
+	o := newRuleBasedOptions(fixedRuleSet("%" + name))
+	return func(x interface{}, opts ...Option) Formatter {
+		return newFormatter(o, opts, x)
+	}
+}
+
+func fixedRuleSet(name string) func(language.Tag) string {
+	return func(language.Tag) string { return name }
+}
+
 // TODO:
 // - Shortest: akin to verb 'g' of 'G'
 //
-// TODO: RBNF forms:
-// - Compact: 1M 3.5T
+// TODO: forms:
 // - CompactBinary: 1Mi 3.5Ti
-// - Long: 1 million
-// - Ordinal:
-// - Roman: MCMIIXX
-// - RomanSmall: mcmiixx
 // - Text: numbers as it typically appears in running text, allowing
 //   language-specific choices for when to use numbers and when to use words.
-// - Spell?: spelled-out number. Maybe just allow as an option?
 
-// NOTE: both spelled-out numbers and ordinals, to render correctly, need
-// detailed linguistic information from the translated string into which they
-// are substituted. We will need to implement that first.
+// NOTE: both spelled-out numbers and ordinals, to render correctly, may need
+// detailed linguistic information, such as grammatical gender, from the
+// translated string into which they are substituted. For now, such forms can
+// be selected explicitly with RuleBased.
@@ -5,6 +5,7 @@
 package number
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 
@@ -174,6 +175,63 @@ func TestFormatter(t *testing.T) {
 		desc: "percent fraction",
 		f:    PerMille(0.12344, Scale(1)),
 		want: "123.4‰",
+	}, {
+		desc: "big.Int",
+		f:    Decimal(mkbigint("-123456789012345678901234567890")),
+		want: "-123,456,789,012,345,678,901,234,567,890",
+	}, {
+		desc: "big.Float",
+		f:    Decimal(big.NewFloat(1234.5)),
+		want: "1,234.5",
+	}, {
+		desc: "big.Rat",
+		f:    Decimal(big.NewRat(1, 3)),
+		want: "0.333",
+	}, {
+		desc: "big.Rat scale",
+		f:    Decimal(big.NewRat(2, 3), Scale(2)),
+		want: "0.67",
+	}, {
+		desc: "big.Rat precision",
+		f:    Decimal(big.NewRat(200000, 3), Precision(3)),
+		want: "66,700",
+	}, {
+		desc: "big.Rat rounding mode",
+		f:    Decimal(big.NewRat(-1, 8), MaxFractionDigits(2), RoundingMode(ToNearestAway)),
+		want: "-0.13",
+	}, {
+		desc: "big.Rat percent",
+		f:    Percent(big.NewRat(1, 3)),
+		want: "33%",
+	}, {
+		desc: "big.Rat scientific",
+		f:    Scientific(big.NewRat(1, 3), Scale(3)),
+		want: "3.333×10⁻¹",
+	}, {
+		desc: "decimal string",
+		f:    Decimal("12345678901234567.89"),
+		want: "12,345,678,901,234,567.89",
+	}, {
+		desc: "decimal string with exponent",
+		f:    Decimal("1.2345e3", Scale(2)),
+		want: "1,234.50",
+	}, {
+		desc: "decimal string rounding mode",
+		f:    Decimal("0.07", MaxFractionDigits(1), RoundingMode(AwayFromZero)),
+		want: "0.1",
+	}, {
+		desc: "decimal string increment",
+		f:    Decimal("0.0251", IncrementString("0.05")),
+		want: "0.05",
+	}, {
+		desc: "decimal string locale",
+		tag:  "de",
+		f:    Decimal("-1234.5"),
+		want: "-1.234,5",
+	}, {
+		desc: "invalid decimal string",
+		f:    Decimal("1,234.5"),
+		want: "NaN",
 	}}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -188,3 +246,8 @@ func TestFormatter(t *testing.T) {
 		})
 	}
 }
+
+func mkbigint(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 10)
+	return x
+}
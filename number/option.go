@@ -16,9 +16,6 @@ type Option option
 
 type option func(tag language.Tag, f *number.Formatter)
 
-// TODO: SpellOut requires support of the ICU RBNF format.
-// func SpellOut() Option
-
 // NoSeparator causes a number to be displayed without grouping separators.
 func NoSeparator() Option {
 	return func(t language.Tag, f *number.Formatter) {
@@ -76,6 +73,77 @@ func Precision(prec int) Option {
 	}
 }
 
+// MinSignificantDigits specifies the minimum number of significant digits,
+// adding trailing zeros when needed. For instance, 1.5 is formatted as "1.50"
+// for a minimum of 3 significant digits.
+//
+// Like a CLDR pattern with significant digits, such as "@@#", it removes the
+// limits on the number of fraction digits of the format. Fraction digit
+// options that follow it are combined with the significant digits according
+// to RoundingPriority.
+func MinSignificantDigits(min int) Option {
+	return func(t language.Tag, f *number.Formatter) {
+		useSignificantDigits(f)
+		if min >= 1<<8 {
+			min = (1 << 8) - 1
+		}
+		if min < 0 {
+			min = 0
+		}
+		f.MinSignificantDigits = uint8(min)
+	}
+}
+
+// MaxSignificantDigits specifies the maximum number of significant digits.
+// For instance, 1234.5 is formatted as "1,230" and 0.012345 as "0.0123" for a
+// maximum of 3 significant digits.
+//
+// Unlike Precision, it removes the limits on the number of fraction digits of
+// the format, in the same way as MinSignificantDigits.
+func MaxSignificantDigits(max int) Option {
+	return func(t language.Tag, f *number.Formatter) {
+		useSignificantDigits(f)
+		if max >= 1<<15 {
+			max = (1 << 15) - 1
+		}
+		if max < 0 {
+			max = 0
+		}
+		f.MaxSignificantDigits = int16(max)
+	}
+}
+
+// useSignificantDigits removes the fraction digit limits of the format the
+// first time a significant digit option is applied.
+func useSignificantDigits(f *number.Formatter) {
+	if f.MinSignificantDigits == 0 && f.MaxSignificantDigits <= 0 {
+		f.MinFractionDigits = 0
+		f.MaxFractionDigits = -1
+	}
+}
+
+// A Priority determines which of the limits on the number of significant
+// digits and fraction digits takes effect if both are set.
+type Priority byte
+
+// Rounding priorities.
+const (
+	LessPrecision Priority = iota // the limit that results in fewer digits.
+	MorePrecision                 // the limit that results in more digits.
+)
+
+// RoundingPriority determines how a number is rounded if both the maximum
+// number of significant digits and the maximum number of fraction digits are
+// set. For instance, for a maximum of 2 significant digits and 1 fraction
+// digit, 1.234 is formatted as "1.2" for either priority, but 0.01234 is
+// formatted as "0" for LessPrecision and as "0.012" for MorePrecision. The
+// minimum number of digits is always honored. The default is LessPrecision.
+func RoundingPriority(p Priority) Option {
+	return func(t language.Tag, f *number.Formatter) {
+		f.Priority = number.RoundingPriority(p)
+	}
+}
+
 // Scale simultaneously sets MinFractionDigits and MaxFractionDigits to the
 // given value.
 func Scale(decimals int) Option {
@@ -114,6 +182,45 @@ func IncrementString(decimal string) Option {
 	}
 }
 
+// A Rounding determines how a number is rounded to the desired precision.
+type Rounding byte
+
+// Rounding modes.
+const (
+	ToNearestEven Rounding = iota // towards the nearest integer, or towards an even number if equidistant.
+	ToNearestZero                 // towards the nearest integer, or towards zero if equidistant.
+	ToNearestAway                 // towards the nearest integer, or away from zero if equidistant.
+	ToPositiveInf                 // towards infinity
+	ToNegativeInf                 // towards negative infinity
+	ToZero                        // towards zero
+	AwayFromZero                  // away from zero
+)
+
+func (r Rounding) String() string {
+	return number.RoundingMode(r).String()
+}
+
+// RoundingMode sets the mode for rounding numbers to the precision, scale, or
+// increment of a format. The default is ToNearestEven.
+func RoundingMode(mode Rounding) Option {
+	return func(t language.Tag, f *number.Formatter) {
+		f.Mode = number.RoundingMode(mode)
+	}
+}
+
+// NumberingSystem sets the numbering system used to format numbers, overriding
+// the "nu" Unicode extension key of the language. The name is a CLDR numbering
+// system identifier, such as "latn" for ASCII digits, "arab" for
+// Arabic-Indic digits, or "deva" for Devanagari digits. The keywords "native",
+// "traditio", and "finance" select the respective numbering system of the
+// language, if defined. Algorithmic numbering systems, such as "hant" for
+// traditional Chinese numerals, are supported as for Numbering.
+func NumberingSystem(name string) Option {
+	return func(t language.Tag, f *number.Formatter) {
+		setNumberingSystem(t, f, name)
+	}
+}
+
 func noop(language.Tag, *number.Formatter) {}
 
 // PatternOverrides allows users to specify alternative patterns for specific
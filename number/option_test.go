@@ -0,0 +1,244 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestRoundingMode(t *testing.T) {
+	modes := []Rounding{
+		ToNearestEven, ToNearestZero, ToNearestAway,
+		ToPositiveInf, ToNegativeInf,
+		ToZero, AwayFromZero,
+	}
+	testCases := []struct {
+		desc string
+		f    FormatFunc
+		x    interface{}
+		opts []Option
+		// want holds the results in the order of modes.
+		want [7]string
+	}{{
+		desc: "decimal",
+		f:    Decimal,
+		x:    2.5,
+		opts: []Option{Scale(0)},
+		want: [7]string{"2", "2", "3", "3", "2", "2", "3"},
+	}, {
+		desc: "decimal",
+		f:    Decimal,
+		x:    -2.5,
+		opts: []Option{Scale(0)},
+		want: [7]string{"-2", "-2", "-3", "-2", "-3", "-2", "-3"},
+	}, {
+		desc: "decimal",
+		f:    Decimal,
+		x:    3.5,
+		opts: []Option{Scale(0)},
+		want: [7]string{"4", "3", "4", "4", "3", "3", "4"},
+	}, {
+		desc: "decimal",
+		f:    Decimal,
+		x:    1.25,
+		opts: []Option{MaxFractionDigits(1)},
+		want: [7]string{"1.2", "1.2", "1.3", "1.3", "1.2", "1.2", "1.3"},
+	}, {
+		desc: "decimal",
+		f:    Decimal,
+		x:    1.2,
+		opts: []Option{Scale(0)},
+		want: [7]string{"1", "1", "1", "2", "1", "1", "2"},
+	}, {
+		desc: "decimal",
+		f:    Decimal,
+		x:    -1.2,
+		opts: []Option{Scale(0)},
+		want: [7]string{"-1", "-1", "-1", "-1", "-2", "-1", "-2"},
+	}, {
+		desc: "decimal",
+		f:    Decimal,
+		x:    0.07,
+		opts: []Option{Scale(2)},
+		want: [7]string{"0.07", "0.07", "0.07", "0.07", "0.07", "0.07", "0.07"},
+	}, {
+		desc: "precision",
+		f:    Decimal,
+		x:    12500,
+		opts: []Option{Precision(2)},
+		want: [7]string{"12,000", "12,000", "13,000", "13,000", "12,000", "12,000", "13,000"},
+	}, {
+		desc: "precision",
+		f:    Decimal,
+		x:    12345,
+		opts: []Option{Precision(2)},
+		want: [7]string{"12,000", "12,000", "12,000", "13,000", "12,000", "12,000", "13,000"},
+	}, {
+		desc: "scientific",
+		f:    Scientific,
+		x:    125000,
+		opts: []Option{Precision(2)},
+		want: [7]string{"1.2×10⁵", "1.2×10⁵", "1.3×10⁵", "1.3×10⁵", "1.2×10⁵", "1.2×10⁵", "1.3×10⁵"},
+	}, {
+		desc: "scientific",
+		f:    Scientific,
+		x:    -0.0135,
+		opts: []Option{Precision(2)},
+		want: [7]string{"-1.4×10⁻²", "-1.3×10⁻²", "-1.4×10⁻²", "-1.3×10⁻²", "-1.4×10⁻²", "-1.3×10⁻²", "-1.4×10⁻²"},
+	}, {
+		desc: "increment",
+		f:    Decimal,
+		x:    1.25,
+		opts: []Option{IncrementString("0.50")},
+		want: [7]string{"1.00", "1.00", "1.50", "1.50", "1.00", "1.00", "1.50"},
+	}, {
+		desc: "increment",
+		f:    Decimal,
+		x:    -1.25,
+		opts: []Option{IncrementString("0.50")},
+		want: [7]string{"-1.00", "-1.00", "-1.50", "-1.00", "-1.50", "-1.00", "-1.50"},
+	}, {
+		desc: "increment",
+		f:    Decimal,
+		x:    1.33,
+		opts: []Option{IncrementString("0.50")},
+		want: [7]string{"1.50", "1.50", "1.50", "1.50", "1.00", "1.00", "1.50"},
+	}, {
+		desc: "percent",
+		f:    Percent,
+		x:    0.125,
+		opts: []Option{Scale(0)},
+		want: [7]string{"12%", "12%", "13%", "13%", "12%", "12%", "13%"},
+	}, {
+		desc: "integer",
+		f:    Decimal,
+		x:    25,
+		opts: []Option{IncrementString("10")},
+		want: [7]string{"20", "20", "30", "30", "20", "20", "30"},
+	}}
+	p := message.NewPrinter(language.English)
+	for _, tc := range testCases {
+		for i, m := range modes {
+			t.Run(fmt.Sprintf("%s/%v/%v", tc.desc, tc.x, m), func(t *testing.T) {
+				opts := append(tc.opts[:len(tc.opts):len(tc.opts)], RoundingMode(m))
+				if got := p.Sprint(tc.f(tc.x, opts...)); got != tc.want[i] {
+					t.Errorf("got %q; want %q", got, tc.want[i])
+				}
+			})
+		}
+	}
+}
+
+func TestRoundingModePrinter(t *testing.T) {
+	p := message.NewPrinter(language.English)
+	testCases := []struct {
+		format string
+		f      Formatter
+		want   string
+	}{
+		{"%.1f", Decimal(1.25, RoundingMode(ToZero)), "1.2"},
+		{"%.1f", Decimal(1.25, RoundingMode(AwayFromZero)), "1.3"},
+		{"%.0f", Decimal(0.4, RoundingMode(ToPositiveInf)), "1"},
+		{"%.2e", Scientific(1.2345, RoundingMode(ToNegativeInf)), "1.23×10⁰"},
+		{"%v", Compact(1290, RoundingMode(ToZero)), "1.2K"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			if got := p.Sprintf(tc.format, tc.f); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSignificantDigits(t *testing.T) {
+	testCases := []struct {
+		f    Formatter
+		want string
+	}{
+		{Decimal(1.5, MinSignificantDigits(3)), "1.50"},
+		{Decimal(0, MinSignificantDigits(3)), "0.00"},
+		{Decimal(1234.5, MaxSignificantDigits(3)), "1,230"},
+		{Decimal(0.012345, MaxSignificantDigits(3)), "0.0123"},
+		{Decimal(0.012345, Precision(3)), "0.012"}, // limited by the pattern
+		{Decimal(99.96, MaxSignificantDigits(3)), "100"},
+		{Decimal(3, MinSignificantDigits(3), MaxSignificantDigits(5)), "3.00"},
+		{Decimal(3.14159, MinSignificantDigits(3), MaxSignificantDigits(5)), "3.1416"},
+		{Decimal(3.14159, MaxSignificantDigits(5), MinSignificantDigits(3)), "3.1416"},
+		{Decimal(1234.5, MaxSignificantDigits(2), RoundingMode(ToPositiveInf)), "1,300"},
+		{Decimal(big.NewRat(1, 3), MaxSignificantDigits(4)), "0.3333"},
+		{Decimal("0.000123456", MaxSignificantDigits(2)), "0.00012"},
+		{Percent(0.123456, MaxSignificantDigits(4)), "12.35%"},
+		{Scientific(12345, MaxSignificantDigits(2)), "1.2×10⁴"},
+		{Scientific(12, MinSignificantDigits(4)), "1.200×10¹"},
+
+		// Combined with fraction digits.
+		{Decimal(1.234, MaxSignificantDigits(2), MaxFractionDigits(1)), "1.2"},
+		{Decimal(0.01234, MaxSignificantDigits(2), MaxFractionDigits(1)), "0"},
+		{Decimal(12345.678, MaxSignificantDigits(2), MaxFractionDigits(1)), "12,000"},
+		{Decimal(1.234, MaxSignificantDigits(2), MaxFractionDigits(1), RoundingPriority(MorePrecision)), "1.2"},
+		{Decimal(0.01234, MaxSignificantDigits(2), MaxFractionDigits(1), RoundingPriority(MorePrecision)), "0.012"},
+		{Decimal(12345.678, MaxSignificantDigits(2), MaxFractionDigits(1), RoundingPriority(MorePrecision)), "12,345.7"},
+		{Decimal(big.NewRat(1, 300), MaxSignificantDigits(2), MaxFractionDigits(1), RoundingPriority(MorePrecision)), "0.0033"},
+	}
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			got := message.NewPrinter(language.English).Sprint(tc.f)
+			if got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNumberingSystem(t *testing.T) {
+	testCases := []struct {
+		lang string
+		f    Formatter
+		want string
+	}{
+		{"ar", Decimal(1234), "١٬٢٣٤"},
+		{"ar-u-nu-latn", Decimal(1234), "1,234"},
+		{"ar", Decimal(1234, NumberingSystem("latn")), "1,234"},
+		{"en", Decimal(1234, NumberingSystem("arab")), "١٬٢٣٤"},
+		{"hi", Decimal(1234, NumberingSystem("native")), "१,२३४"},
+		{"hi-u-nu-native", Decimal(1234), "१,२३४"},
+		{"en-u-nu-native", Decimal(1234), "1,234"},
+		{"en", Decimal(1234, NumberingSystem("unknown")), "1,234"},
+		{"zh-u-nu-hanidec", Decimal(1204.5), "一,二〇四.五"},
+		{"zh", Decimal(1204.5, NumberingSystem("native")), "一,二〇四.五"},
+		{"zh-TW-u-nu-hanidec", Decimal(1204), "一,二〇四"},
+		{"en-u-nu-hanidec", Percent(0.5), "五〇%"},
+
+		// Algorithmic numbering systems.
+		{"zh-u-nu-traditio", Decimal(10010), "一万零一十"},
+		{"zh-TW-u-nu-traditio", Decimal(10010), "一萬零一十"},
+		{"zh-u-nu-finance", Decimal(1234), "壹仟贰佰叁拾肆"},
+		{"zh-Hant", Decimal(-1234.5, NumberingSystem("finance")), "負壹仟貳佰參拾肆點伍"},
+		{"ja", Decimal(1010, NumberingSystem("traditio")), "千十"},
+		{"ja-u-nu-jpan", Decimal(100001234), "一億千二百三十四"},
+		{"en", Decimal(14, NumberingSystem("romanlow")), "xiv"},
+		{"en-u-nu-roman", Decimal(5000), "5,000"},
+
+		// The option overrides the numbering system of Numbering.
+		{"en", Numbering(1234), "MCCXXXIV"},
+		{"ar", Numbering(1234), "MCCXXXIV"},
+		{"en", Numbering(1234, NumberingSystem("hant")), "一千二百三十四"},
+		{"zh-u-nu-hans", Numbering(110), "一百一十"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lang+"/"+tc.want, func(t *testing.T) {
+			p := message.NewPrinter(language.MustParse(tc.lang))
+			if got := p.Sprint(tc.f); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"strconv"
+
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A ParseOption configures the parsing of numbers.
+type ParseOption func(p *number.Parser)
+
+// Lenient selects lenient parsing. By default, only numbers that are formatted
+// exactly as they would be by the decimal, percent, and permille formats of a
+// language are accepted. Lenient parsing is suitable for user input: it
+// ignores surrounding white space and bidi marks, accepts ASCII digits as well
+// as native digits, variants of the grouping, sign, and percent symbols,
+// grouping separators at any position within the integer part, and an
+// exponent, such as "1.5e3".
+func Lenient() ParseOption {
+	return func(p *number.Parser) { p.Lenient = true }
+}
+
+// Parse parses a number formatted according to the conventions of language t,
+// such as "1.234,56" in German or "١٢٣" in Arabic. A value followed by a
+// percent or permille sign is divided by 100 or 1000, respectively.
+//
+// Parse returns the nearest floating-point number. Use ParseDecimal to obtain
+// the exact value.
+func Parse(t language.Tag, s string, opts ...ParseOption) (float64, error) {
+	d, err := ParseDecimal(t, s, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(d, 64)
+}
+
+// ParseDecimal parses a number formatted according to the conventions of
+// language t and returns its exact value as a decimal string in the syntax
+// accepted by strconv.ParseFloat and big.Rat.SetString, such as "-1234.50".
+// The special values are returned as "Inf", "-Inf", and "NaN". Trailing zeros
+// in the fraction of s are retained.
+func ParseDecimal(t language.Tag, s string, opts ...ParseOption) (string, error) {
+	p := newParser(t, opts)
+	d, _, err := p.Parse(s)
+	return d, err
+}
+
+// ParseCurrency parses a currency amount formatted according to the
+// conventions of language t, such as "€ 1.234,50" in German. It returns the
+// exact value, as ParseDecimal does, and the currency symbol or code that
+// precedes or follows the number, if any. Package currency can be used to
+// identify the currency for a symbol.
+func ParseCurrency(t language.Tag, s string, opts ...ParseOption) (value, symbol string, err error) {
+	p := newParser(t, opts)
+	p.Currency = true
+	return p.Parse(s)
+}
+
+func newParser(t language.Tag, opts []ParseOption) *number.Parser {
+	p := &number.Parser{}
+	p.Init(t)
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
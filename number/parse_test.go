@@ -0,0 +1,79 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		in   string
+		opts []ParseOption
+		want float64
+		err  bool
+	}{
+		{tag: "en", in: "1,234.5", want: 1234.5},
+		{tag: "de", in: "1.234,5", want: 1234.5},
+		{tag: "de", in: "-12,5 %", want: -0.125},
+		{tag: "ar", in: "١٢٣", want: 123},
+		{tag: "en", in: "-∞", want: math.Inf(-1)},
+		{tag: "en", in: "1e3", err: true},
+		{tag: "en", in: "1e3", opts: []ParseOption{Lenient()}, want: 1000},
+		{tag: "en", in: "1e400", opts: []ParseOption{Lenient()}, want: math.Inf(1), err: true},
+		{tag: "en", in: "one", err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag+"/"+tc.in, func(t *testing.T) {
+			got, err := Parse(language.MustParse(tc.tag), tc.in, tc.opts...)
+			if (err != nil) != tc.err {
+				t.Errorf("error was %v; want error %v", err, tc.err)
+			}
+			if !tc.err && got != tc.want {
+				t.Errorf("got %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		in   string
+		opts []ParseOption
+		want string
+	}{
+		{"en", "1,234.50", nil, "1234.50"},
+		{"en", "0.1%", nil, "0.001"},
+		{"en", "12345678901234567890.123456789", nil, "12345678901234567890.123456789"},
+		{"de", "−3", []ParseOption{Lenient()}, "-3"},
+		{"en", "NaN", nil, "NaN"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag+"/"+tc.in, func(t *testing.T) {
+			got, err := ParseDecimal(language.MustParse(tc.tag), tc.in, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	value, symbol, err := ParseCurrency(language.German, "1.234,50 €")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "1234.50" || symbol != "€" {
+		t.Errorf("got %q, %q; want %q, %q", value, symbol, "1234.50", "€")
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A PartKind identifies the kind of a part of a formatted number.
+type PartKind byte
+
+// Kinds of parts.
+const (
+	LiteralPart           PartKind = iota // text of a pattern, such as a space
+	IntegerPart                           // integer digits between group separators
+	GroupPart                             // group separator
+	DecimalPart                           // decimal separator
+	FractionPart                          // fraction digits
+	MinusSignPart                         // minus sign
+	PlusSignPart                          // plus sign
+	ExponentSeparatorPart                 // exponent symbol, such as "E" or "×10"
+	ExponentMinusSignPart                 // minus sign of the exponent
+	ExponentPlusSignPart                  // plus sign of the exponent
+	ExponentIntegerPart                   // exponent digits
+	PercentSignPart                       // percent sign
+	PerMilleSignPart                      // per mille sign
+	CurrencyPart                          // currency symbol, code, or name
+	InfinityPart                          // infinity symbol
+	NaNPart                               // not-a-number symbol
+	CompactPart                           // abbreviation of a compact number, such as "K"
+)
+
+func (k PartKind) String() string {
+	return number.PartKind(k).String()
+}
+
+// A Part is a part of a formatted number, such as its integer digits or its
+// decimal separator. Start and End are the byte offsets of the part in the
+// formatted number.
+type Part struct {
+	Kind       PartKind
+	Start, End int
+}
+
+// FormatToParts formats the number of f for language t and returns the result
+// along with its parts. The parts are in order and cover the result without
+// gaps, so the result can be rendered with a different style for each kind of
+// part, such as a smaller font for the fraction digits. Concatenating the
+// parts yields the same string as formatting f with a message.Printer for t.
+//
+// Rule-based formats, such as SpellOut, are reported as a single literal.
+func (f Formatter) FormatToParts(t language.Tag) (string, []Part) {
+	var parts []number.Part
+	b := f.appendFormat(nil, &parts, t, noState{}, 'v')
+	p := make([]Part, len(parts))
+	for i, x := range parts {
+		p[i] = Part{PartKind(x.Kind), x.Start, x.End}
+	}
+	return string(b), p
+}
+
+// noState is a fmt.State without flags, width, or precision.
+type noState struct{}
+
+func (noState) Write(b []byte) (int, error) { return len(b), nil }
+func (noState) Width() (w int, ok bool)     { return 0, false }
+func (noState) Precision() (p int, ok bool) { return 0, false }
+func (noState) Flag(c int) bool             { return false }
+
+// appendLiteral appends s to dst and records it as a literal if parts is not
+// nil.
+func appendLiteral(dst []byte, parts *[]number.Part, s string) []byte {
+	start := len(dst)
+	dst = append(dst, s...)
+	if parts != nil {
+		*parts = appendPart(*parts, number.LiteralPart, start, len(dst))
+	}
+	return dst
+}
+
+// markCompact marks the literal text of the parts starting at index n,
+// excluding any surrounding white space, as the abbreviation of a compact
+// number.
+func markCompact(parts *[]number.Part, n int, b []byte) {
+	p := (*parts)[:n:n]
+	for _, x := range (*parts)[n:] {
+		if x.Kind != number.LiteralPart {
+			p = append(p, x)
+			continue
+		}
+		start, end := x.Start, x.End
+		for start < end {
+			r, size := utf8.DecodeRune(b[start:end])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			start += size
+		}
+		for end > start {
+			r, size := utf8.DecodeLastRune(b[start:end])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			end -= size
+		}
+		if start == end {
+			p = append(p, x)
+			continue
+		}
+		p = appendPart(p, number.LiteralPart, x.Start, start)
+		p = appendPart(p, number.CompactPart, start, end)
+		p = appendPart(p, number.LiteralPart, end, x.End)
+	}
+	*parts = p
+}
+
+func appendPart(p []number.Part, k number.PartKind, start, end int) []number.Part {
+	if start < end {
+		p = append(p, number.Part{Kind: k, Start: start, End: end})
+	}
+	return p
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestFormatToParts(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", Decimal(-1234.5), "MinusSign:- Integer:1 Group:, Integer:234 Decimal:. Fraction:5"},
+		{"de", Percent(0.25), "Integer:25 Literal:\u00a0 PercentSign:%"},
+		{"en", PerMille(0.025), "Integer:25 PerMilleSign:‰"},
+		{"en", Scientific(1234), "Integer:1 Decimal:. Fraction:234 ExponentSeparator:×10 ExponentInteger:³"},
+		{"en", Scientific(0.0012), "Integer:1 Decimal:. Fraction:2 ExponentSeparator:×10 ExponentMinusSign:⁻ ExponentInteger:³"},
+		{"en", Engineering(-12345), "MinusSign:- Integer:12 Decimal:. Fraction:345 ExponentSeparator:×10 ExponentInteger:³"},
+		{"en", Decimal(math.NaN()), "NaN:NaN"},
+		{"en", Decimal(math.Inf(-1)), "MinusSign:- Infinity:∞"},
+		{"en", Compact(1500), "Integer:1 Decimal:. Fraction:5 Compact:K"},
+		{"de", CompactLong(1200000), "Integer:1 Decimal:, Fraction:2 Literal:  Compact:Millionen"},
+		{"it", CompactLong(1000), "Compact:mille"},
+		{"ja", Compact(12000), "Integer:1 Decimal:. Fraction:2 Compact:万"},
+		{"en", Compact(12), "Integer:12"},
+		{"en", Approximately(5), "Literal:~ Integer:5"},
+		{"ja", AtMost(5), "Integer:5 Literal: 以下"},
+		{"en", SpellOut(12), "Literal:twelve"},
+		{"en", Decimal(12, FormatWidth(6)), "Literal:     Integer:12"},
+		{"en", Decimal(12, Pad('*'), FormatWidth(6)), "Literal:**** Integer:12"},
+		{"ar", Decimal(-1234.5), "MinusSign:\u061c- Integer:١ Group:٬ Integer:٢٣٤ Decimal:٫ Fraction:٥"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.tag, "/", tc.f.value), func(t *testing.T) {
+			tag := language.MustParse(tc.tag)
+			s, parts := tc.f.FormatToParts(tag)
+			if want := message.NewPrinter(tag).Sprint(tc.f); s != want {
+				t.Errorf("got %q; want %q", s, want)
+			}
+			var got []string
+			end := 0
+			for _, p := range parts {
+				if p.Start != end || p.End <= p.Start {
+					t.Errorf("part %v [%d, %d] does not start at %d", p.Kind, p.Start, p.End, end)
+				}
+				end = p.End
+				got = append(got, strings.TrimSuffix(p.Kind.String(), "Part")+":"+s[p.Start:p.End])
+			}
+			if end != len(s) {
+				t.Errorf("parts end at %d; want %d", end, len(s))
+			}
+			if g := strings.Join(got, " "); g != tc.want {
+				t.Errorf("got %q;\nwant %q", g, tc.want)
+			}
+		})
+	}
+}
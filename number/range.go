@@ -0,0 +1,275 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/format"
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A miscPattern selects one of the CLDR miscPatterns of a language.
+type miscPattern uint8
+
+const (
+	miscApproximately miscPattern = iota
+	miscAtLeast
+	miscAtMost
+	miscRange
+
+	numMiscPatterns
+
+	noMisc = numMiscPatterns
+)
+
+// Approximately formats a number as an approximation, such as "~5" in English
+// or "≈5" in German. If x is a Formatter, such as one returned by Percent, the
+// number is formatted accordingly and opts are added to its options.
+// Otherwise x is formatted as a decimal.
+func Approximately(x interface{}, opts ...Option) Formatter {
+	return newMiscFormatter(miscApproximately, x, opts)
+}
+
+// AtLeast formats a number as a lower limit, such as "5+" in English or "≥5"
+// in French. Its arguments are interpreted as for Approximately.
+func AtLeast(x interface{}, opts ...Option) Formatter {
+	return newMiscFormatter(miscAtLeast, x, opts)
+}
+
+// AtMost formats a number as an upper limit, such as "≤5". Its arguments are
+// interpreted as for Approximately.
+func AtMost(x interface{}, opts ...Option) Formatter {
+	return newMiscFormatter(miscAtMost, x, opts)
+}
+
+func newMiscFormatter(m miscPattern, x interface{}, opts []Option) Formatter {
+	f := withOptions(x, opts)
+	o := *f.options
+	o.misc = m
+	f.options = &o
+	return f
+}
+
+// withOptions returns a Formatter for x with opts added to its options. If x
+// is not a Formatter, it is formatted as a decimal.
+func withOptions(x interface{}, opts []Option) Formatter {
+	if f, ok := x.(Formatter); ok {
+		o := *f.options
+		n := len(o.options)
+		o.options = append(o.options[:n:n], opts...)
+		return Formatter{&o, f.value}
+	}
+	return newFormatter(decimalOptions, opts, x)
+}
+
+// A RangeFormatter formats a range of numbers.
+type RangeFormatter struct {
+	lo, hi Formatter
+}
+
+// Range formats a range of numbers, such as "3–5" in English. The end points
+// are interpreted as for Approximately. Parts that are identical for both end
+// points, such as the percent sign in "3–5%", are shown only once. If both end
+// points are formatted identically, the range is formatted as a single number
+// or, if the values differ, as an approximation, such as "~5".
+//
+// A RangeFormatter passed as an argument to a plural selection selects the
+// plural form of the range as defined by the CLDR plural ranges of the
+// language. For instance, in English, the range "0–1" selects the form One.
+func Range(lo, hi interface{}, opts ...Option) RangeFormatter {
+	return RangeFormatter{withOptions(lo, opts), withOptions(hi, opts)}
+}
+
+// Format implements fmt.Formatter. It formats the range in the language of
+// state if it implements format.State, and in the root language otherwise.
+func (r RangeFormatter) Format(state fmt.State, verb rune) {
+	lang := language.Und
+	if s, ok := state.(format.State); ok {
+		lang = s.Language()
+	}
+	if !strings.Contains(r.lo.verbs, string(verb)) || !strings.Contains(r.hi.verbs, string(verb)) {
+		fmt.Fprintf(state, "%%!%s(%T=%v, %T=%v)", string(verb), r.lo.value, r.lo.value, r.hi.value, r.hi.value)
+		return
+	}
+	state.Write(r.appendFormat(nil, lang, rangeState{state}, verb))
+}
+
+// rangeState suppresses the width of the state for the end points of a range.
+type rangeState struct {
+	fmt.State
+}
+
+func (rangeState) Width() (w int, ok bool) { return 0, false }
+
+func (r RangeFormatter) appendFormat(dst []byte, t language.Tag, s fmt.State, verb rune) []byte {
+	lo := r.lo.appendFormat(nil, nil, t, s, verb)
+	hi := r.hi.appendFormat(nil, nil, t, s, verb)
+	patterns := lookupMisc(t)
+	loValue, hiValue := r.lo.exact(), r.hi.exact()
+	if bytes.Equal(lo, hi) {
+		if equalDecimal(&loValue, &hiValue) {
+			return append(dst, lo...)
+		}
+		return appendMisc(dst, patterns[miscApproximately], lo)
+	}
+
+	pattern := patterns[miscRange]
+	i := strings.Index(pattern, "{0}")
+	j := strings.Index(pattern, "{1}")
+	if i < 0 || j < i {
+		pattern, i, j = "{0}–{1}", 0, len("{0}–")
+	}
+	sep := pattern[i+len("{0}") : j]
+
+	loPrefix, loNum, loSuffix := splitAffixes(lo)
+	hiPrefix, hiNum, hiSuffix := splitAffixes(hi)
+	dst = append(dst, pattern[:i]...)
+	switch {
+	case loPrefix == hiPrefix && loSuffix == hiSuffix && loNum != "" && hiNum != "" &&
+		!isNegative(&loValue) && !isNegative(&hiValue):
+		dst = append(dst, loPrefix...)
+		dst = append(dst, loNum...)
+		dst = append(dst, sep...)
+		dst = append(dst, hiNum...)
+		dst = append(dst, hiSuffix...)
+	default:
+		// Separate the end points by spaces if they cannot be collapsed, as in
+		// "-5 – -3" or "3K – 5M".
+		if loNum != "" && hiNum != "" && loPrefix+loSuffix+hiPrefix+hiSuffix != "" &&
+			strings.TrimFunc(sep, unicode.IsSpace) == sep {
+			sep = " " + sep + " "
+		}
+		dst = append(dst, lo...)
+		dst = append(dst, sep...)
+		dst = append(dst, hi...)
+	}
+	return append(dst, pattern[j+len("{1}"):]...)
+}
+
+// PluralForm implements plural.Interface. The plural form of a range is
+// derived from the plural forms of its end points as defined by the CLDR
+// plural ranges of language t. The integer value of a range is reported as -1.
+func (r RangeFormatter) PluralForm(t language.Tag, scale int) (f plural.Form, n int) {
+	lo := r.lo.Digits(nil, t, scale)
+	hi := r.hi.Digits(nil, t, scale)
+	start := plural.Cardinal.MatchDigits(t, lo.Digits, int(lo.Exp), lo.NumFracDigits())
+	end := plural.Cardinal.MatchDigits(t, hi.Digits, int(hi.Exp), hi.NumFracDigits())
+	return pluralRange(t, start, end), -1
+}
+
+// exact returns the exact value of the number of f.
+func (f Formatter) exact() number.Decimal {
+	var d number.Decimal
+	d.Convert(number.RoundingContext{MaxFractionDigits: -1}, f.value)
+	return d
+}
+
+// equalDecimal reports whether a and b represent the same number.
+func equalDecimal(a, b *number.Decimal) bool {
+	if a.NaN || b.NaN {
+		return false
+	}
+	if a.Inf || b.Inf {
+		return a.Inf == b.Inf && a.Neg == b.Neg
+	}
+	da, db := trimZeros(a.Digits), trimZeros(b.Digits)
+	if len(da) == 0 || len(db) == 0 {
+		return len(da) == len(db)
+	}
+	return a.Neg == b.Neg && a.Exp == b.Exp && bytes.Equal(da, db)
+}
+
+func trimZeros(b []byte) []byte {
+	for len(b) > 0 && b[len(b)-1] == 0 {
+		b = b[:len(b)-1]
+	}
+	return b
+}
+
+func isNegative(d *number.Decimal) bool {
+	return d.Neg && (d.Inf || len(trimZeros(d.Digits)) > 0)
+}
+
+// splitAffixes splits a formatted number into the text before the first digit,
+// the number, and the text after the last digit.
+func splitAffixes(b []byte) (prefix, num, suffix string) {
+	start, end := -1, 0
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		if unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			end = i + size
+		}
+		i += size
+	}
+	if start < 0 {
+		return string(b), "", ""
+	}
+	return string(b[:start]), string(b[start:end]), string(b[end:])
+}
+
+// appendMisc appends b substituted in the given miscPattern.
+func appendMisc(dst []byte, pattern string, b []byte) []byte {
+	before, after, ok := strings.Cut(pattern, "{0}")
+	if !ok {
+		return append(dst, b...)
+	}
+	dst = append(dst, before...)
+	dst = append(dst, b...)
+	return append(dst, after...)
+}
+
+var miscCache sync.Map // map[language.Tag]*[numMiscPatterns]string
+
+// lookupMisc returns the miscPatterns for the given language, resolved against
+// the parents of the language.
+func lookupMisc(t language.Tag) *[numMiscPatterns]string {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	if p, ok := miscCache.Load(key); ok {
+		return p.(*[numMiscPatterns]string)
+	}
+	p := miscPatternData["und"]
+	for t := key; ; t = t.Parent() {
+		if d, ok := miscPatternData[t.String()]; ok {
+			p = d
+			break
+		}
+		if t.IsRoot() {
+			break
+		}
+	}
+	miscCache.Store(key, p)
+	return p
+}
+
+// pluralRange returns the plural form of a range from a number with plural
+// form start to a number with plural form end.
+func pluralRange(t language.Tag, start, end plural.Form) plural.Form {
+	b, _ := t.Base()
+	for _, s := range strings.Split(pluralRangeData[b.String()], "|") {
+		forms, result, ok := strings.Cut(s, "=")
+		if !ok {
+			continue
+		}
+		from, to, _ := strings.Cut(forms, "+")
+		if pluralForms[from] == start && pluralForms[to] == end {
+			if f, ok := pluralForms[result]; ok {
+				return f
+			}
+		}
+	}
+	return end
+}
@@ -0,0 +1,38 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+// miscPatternData holds the CLDR miscPatterns of the Latin numbering system
+// for a selection of languages, indexed by language and miscPattern.
+var miscPatternData = map[string]*[numMiscPatterns]string{
+	"und": {"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+	"de":  {"≈{0}", "{0}+", "≤{0}", "{0}–{1}"},
+	"en":  {"~{0}", "{0}+", "≤{0}", "{0}–{1}"},
+	"es":  {"~{0}", "Más de {0}", "≤{0}", "{0}-{1}"},
+	"fr":  {"≃{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+	"it":  {"~{0}", "≥{0}", "≤{0}", "{0}-{1}"},
+	"ja":  {"約 {0}", "{0} 以上", "{0} 以下", "{0}～{1}"},
+	"nl":  {"~{0}", "{0}+", "≤ {0}", "{0}-{1}"},
+	"ru":  {"≈{0}", "≥{0}", "≤{0}", "{0}–{1}"},
+	"zh":  {"~{0}", "{0}+", "≤{0}", "{0}-{1}"},
+}
+
+// pluralRangeData holds the CLDR plural ranges for a selection of languages.
+// An entry start+end=result indicates that a range from a number of plural
+// form start to a number of plural form end has the plural form result. The
+// plural form of ranges not listed is that of the end of the range.
+var pluralRangeData = map[string]string{
+	"de": "one+other=other|other+one=one|other+other=other",
+	"en": "one+other=other|other+one=one|other+other=other",
+	"es": "one+other=other|other+other=other",
+	"fr": "one+one=one|one+other=other|other+other=other",
+	"it": "one+other=other|other+one=one|other+other=other",
+	"nl": "one+other=other|other+one=one|other+other=other",
+	"ro": "one+few=few|one+other=other|few+one=few|few+few=few|few+other=other|other+few=few|other+other=other",
+	"ru": "one+one=one|one+few=few|one+many=many|one+other=other|" +
+		"few+one=one|few+few=few|few+many=many|few+other=other|" +
+		"many+one=one|many+few=few|many+many=many|many+other=other|" +
+		"other+one=one|other+few=few|other+many=many|other+other=other",
+}
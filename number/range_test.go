@@ -0,0 +1,170 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestRange(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    interface{}
+		fmt  string
+		want string
+	}{
+		{"en", Range(3, 5), "%v", "3–5"},
+		{"en", Range(3000, 5000), "%v", "3,000–5,000"},
+		{"en", Range(3, 5), "%.1f", "3.0–5.0"},
+		{"en", Range(3, 5), "%6v", "3–5"},
+		{"de", Range(1.5, 2.5), "%v", "1,5–2,5"},
+		{"ja", Range(3, 5), "%v", "3～5"},
+		{"nl", Range(3, 5), "%v", "3-5"},
+		{"en-GB", Range(3, 5), "%v", "3–5"},
+		{"und", Range(3, 5), "%v", "3–5"},
+
+		// Collapse identical parts.
+		{"en", Range(Percent(0.03), Percent(0.05)), "%v", "3–5%"},
+		{"fr", Range(Percent(0.03), Percent(0.05)), "%v", "3–5 %"},
+		{"en", Range(Compact(3000), Compact(5000)), "%v", "3–5K"},
+		{"de", Range(CompactLong(1200000), CompactLong(3400000)), "%v", "1,2–3,4 Millionen"},
+		{"en", Range(Decimal(0.03), Percent(0.05)), "%v", "0.03 – 5%"},
+		{"en", Range(Compact(3000), Compact(5000000)), "%v", "3K – 5M"},
+
+		// Negative numbers.
+		{"en", Range(-5, -3), "%v", "-5 – -3"},
+		{"en", Range(-5, 3), "%v", "-5 – 3"},
+		{"en", Range(Percent(-0.05), Percent(-0.03)), "%v", "-5% – -3%"},
+
+		// Identity fallback.
+		{"en", Range(5, 5), "%v", "5"},
+		{"en", Range(5, 5.0), "%v", "5"},
+		{"en", Range(5, "5.00"), "%v", "5"},
+		{"en", Range(4.99, 5.01, MaxFractionDigits(0)), "%v", "~5"},
+		{"de", Range(4.99, 5.01, MaxFractionDigits(0)), "%v", "≈5"},
+		{"en", Range(Percent(0.499), Percent(0.501)), "%v", "~50%"},
+
+		// Rule-based formats have no digits to collapse.
+		{"en", Range(SpellOut(3), SpellOut(5)), "%v", "three–five"},
+
+		{"en", Range(3, 5), "%e", "%!e(int=3, int=5)"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.tag, "/", tc.want), func(t *testing.T) {
+			p := message.NewPrinter(language.MustParse(tc.tag))
+			if got := p.Sprintf(tc.fmt, tc.f); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMiscPatterns(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", Approximately(5), "~5"},
+		{"en", Approximately(1234.5678, MaxFractionDigits(0)), "~1,235"},
+		{"de", Approximately(Percent(0.5)), "≈50 %"},
+		{"en", AtLeast(5), "5+"},
+		{"fr", AtLeast(5), "≥5"},
+		{"es", AtLeast(5), "Más de 5"},
+		{"en", AtMost(5), "≤5"},
+		{"ja", AtMost(5), "5 以下"},
+		{"en", AtLeast(Compact(1500000)), "1.5M+"},
+		{"pt", Approximately(5), "~5"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.tag, "/", tc.want), func(t *testing.T) {
+			p := message.NewPrinter(language.MustParse(tc.tag))
+			if got := p.Sprint(tc.f); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRangePluralForm(t *testing.T) {
+	testCases := []struct {
+		tag   string
+		r     RangeFormatter
+		scale int
+		want  plural.Form
+	}{
+		{"en", Range(0, 1), -1, plural.One},
+		{"en", Range(1, 2), -1, plural.Other},
+		{"en", Range(0, 1), 1, plural.Other}, // 0.0–1.0
+		{"fr", Range(0, 1), -1, plural.One},
+		{"ru", Range(1, 2), -1, plural.Few},
+		{"ru", Range(1, 5), -1, plural.Many},
+		{"ru", Range(2, 21), -1, plural.One},
+		{"ro", Range(1, 20), -1, plural.Other},
+		{"ro", Range(2, 1), -1, plural.Few},
+		{"ja", Range(1, 2), -1, plural.Other},
+		{"pl", Range(1, 2), -1, plural.Few}, // no data: form of the end
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.tag, "/", tc.want), func(t *testing.T) {
+			got, n := tc.r.PluralForm(language.MustParse(tc.tag), tc.scale)
+			if got != tc.want || n != -1 {
+				t.Errorf("got %v, %d; want %v, -1", got, n, tc.want)
+			}
+		})
+	}
+}
+
+func TestRangePluralSelection(t *testing.T) {
+	message.Set(language.English, "%v days", plural.Selectf(1, "",
+		"one", "%v day",
+		"other", "%v days"))
+	p := message.NewPrinter(language.English)
+	// Indirect the call to p.Sprintf through the variable f to avoid vet
+	// complaining about the non-constant format string. See TestPluralIntegration.
+	f := p.Sprintf
+	for r, want := range map[RangeFormatter]string{
+		Range(0, 1): "0–1 day",
+		Range(1, 3): "1–3 days",
+	} {
+		if got := f("%v days", r); got != want {
+			t.Errorf("got %q; want %q", got, want)
+		}
+	}
+}
+
+func TestRangeData(t *testing.T) {
+	for lang, p := range miscPatternData {
+		if _, err := language.Parse(lang); err != nil {
+			t.Errorf("%s: %v", lang, err)
+		}
+		for i, s := range p[:miscRange] {
+			if strings.Count(s, "{0}") != 1 {
+				t.Errorf("%s:%d: pattern %q must have exactly one {0}", lang, i, s)
+			}
+		}
+		if s := p[miscRange]; strings.Index(s, "{0}") < 0 || strings.Index(s, "{1}") < strings.Index(s, "{0}") {
+			t.Errorf("%s: invalid range pattern %q", lang, s)
+		}
+	}
+	for lang, s := range pluralRangeData {
+		for _, r := range strings.Split(s, "|") {
+			forms, result, ok := strings.Cut(r, "=")
+			from, to, ok2 := strings.Cut(forms, "+")
+			_, ok3 := pluralForms[from]
+			_, ok4 := pluralForms[to]
+			_, ok5 := pluralForms[result]
+			if !ok || !ok2 || !ok3 || !ok4 || !ok5 {
+				t.Errorf("%s: invalid plural range %q", lang, r)
+			}
+		}
+	}
+}
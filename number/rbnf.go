@@ -0,0 +1,488 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+// This file implements rule-based number formatting (RBNF) as defined in
+// https://unicode.org/reports/tr35/tr35-numbers.html#Rule-Based_Number_Formatting.
+// Rules are written in the ICU syntax. For example, the rule
+//
+//	100: << hundred[ >>];
+//
+// formats numbers from 100 up to the base value of the next rule. The
+// substitution << formats the number divided by 100, >> formats the remainder,
+// and the text in brackets is omitted if the remainder is zero.
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// A ruleSet is a named set of rules, such as %spellout-cardinal. Names of
+// rule sets that may only be referred to from other rule sets start with %%.
+type ruleSet struct {
+	name     string
+	rules    []*rule // normal rules, ordered by base value
+	negative *rule   // -x: negative numbers
+	fraction *rule   // x.x: numbers with a fractional part
+	proper   *rule   // 0.x: numbers between 0 and 1
+	inf      *rule
+	nan      *rule
+}
+
+// A rule formats numbers from its base value up to the base value of the
+// next rule in its rule set.
+type rule struct {
+	base    uint64
+	divisor uint64
+	parts   []rulePart
+}
+
+// A rulePart is a fragment of the text of a rule. It is either literal text,
+// a plural selection, or a substitution.
+type rulePart struct {
+	text string
+
+	// sub is '<', '>', or '=' for a substitution and 0 otherwise. The value
+	// substituted is formatted using ruleSet, if set, pattern, if set, or the
+	// rule set of the rule otherwise.
+	sub     byte
+	ruleSet string
+	pattern *number.Pattern
+
+	// plural selects the text from forms based on the plural form of the
+	// number divided by the divisor of the rule.
+	plural *plural.Rules
+	forms  map[plural.Form]string
+
+	// optional indicates that the part is omitted if the remainder of the
+	// number divided by the divisor of the rule is zero.
+	optional bool
+
+	// noSpace indicates that the digits of a fraction substituted by >>> are
+	// not separated by spaces.
+	noSpace bool
+}
+
+// parseRules parses a list of rule sets in ICU syntax. Rule sets start with a
+// name followed by a colon; rules are terminated by a semicolon.
+func parseRules(s string) (map[string]*ruleSet, error) {
+	sets := map[string]*ruleSet{}
+	var set *ruleSet
+	for _, stmt := range strings.Split(s, ";") {
+		stmt = strings.TrimSpace(stmt)
+		if strings.HasPrefix(stmt, "%") {
+			name, rest, ok := strings.Cut(stmt, ":")
+			if !ok {
+				return nil, fmt.Errorf("number: missing colon after rule set %q", stmt)
+			}
+			set = &ruleSet{name: name}
+			sets[name] = set
+			stmt = strings.TrimSpace(rest)
+		}
+		if stmt == "" {
+			continue
+		}
+		if set == nil {
+			return nil, fmt.Errorf("number: rule %q outside rule set", stmt)
+		}
+		if err := set.addRule(stmt); err != nil {
+			return nil, err
+		}
+	}
+	for _, set := range sets {
+		sort.SliceStable(set.rules, func(i, j int) bool {
+			return set.rules[i].base < set.rules[j].base
+		})
+	}
+	return sets, nil
+}
+
+// addRule parses a rule of the form "descriptor: text" and adds it to s.
+func (s *ruleSet) addRule(stmt string) error {
+	desc, text, ok := strings.Cut(stmt, ":")
+	if !ok {
+		return fmt.Errorf("number: missing descriptor in rule %q", stmt)
+	}
+	parts, err := parseRuleText(strings.TrimLeft(text, " \n\t"))
+	if err != nil {
+		return err
+	}
+	r := &rule{divisor: 1, parts: parts}
+	switch desc = strings.TrimSpace(desc); desc {
+	case "-x":
+		s.negative = r
+	case "x.x":
+		s.fraction = r
+	case "0.x":
+		s.proper = r
+	case "Inf":
+		s.inf = r
+	case "NaN":
+		s.nan = r
+	default:
+		radix := uint64(10)
+		if i := strings.IndexByte(desc, '/'); i >= 0 {
+			if radix, err = strconv.ParseUint(desc[i+1:], 10, 64); err != nil || radix < 2 {
+				return fmt.Errorf("number: invalid radix in rule %q", stmt)
+			}
+			desc = desc[:i]
+		}
+		base, err := strconv.ParseUint(strings.Replace(desc, ",", "", -1), 10, 64)
+		if err != nil {
+			return fmt.Errorf("number: invalid descriptor in rule %q", stmt)
+		}
+		r.base = base
+		for r.divisor <= base/radix {
+			r.divisor *= radix
+		}
+		s.rules = append(s.rules, r)
+	}
+	return nil
+}
+
+// parseRuleText parses the text of a rule. A leading apostrophe is removed,
+// allowing the text to start with white space.
+func parseRuleText(s string) (parts []rulePart, err error) {
+	s = strings.TrimPrefix(s, "'")
+	optional := false
+	start := 0
+	flush := func(end int) {
+		if start < end {
+			parts = append(parts, rulePart{text: s[start:end], optional: optional})
+		}
+	}
+	for i := 0; i < len(s); {
+		switch c := s[i]; c {
+		case '[', ']':
+			flush(i)
+			optional = c == '['
+			i++
+		case '<', '>', '=':
+			flush(i)
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("number: unterminated substitution in %q", s)
+			}
+			p := rulePart{sub: c, optional: optional}
+			switch desc := s[i+1 : i+1+end]; {
+			case strings.HasPrefix(desc, "%"):
+				p.ruleSet = desc
+			case desc != "":
+				if p.pattern, err = number.ParsePattern(desc); err != nil {
+					return nil, err
+				}
+			case c == '=':
+				return nil, fmt.Errorf("number: substitution == in %q must name a rule set or pattern", s)
+			}
+			i += end + 2
+			if c == '>' && strings.HasPrefix(s[i:], ">") {
+				p.noSpace = true // >>>
+				i++
+			}
+			parts = append(parts, p)
+		case '$':
+			end := strings.Index(s[i:], ")$")
+			if !strings.HasPrefix(s[i:], "$(") || end < 0 {
+				i++
+				continue
+			}
+			flush(i)
+			p, err := parsePlural(s[i+2 : i+end])
+			if err != nil {
+				return nil, err
+			}
+			p.optional = optional
+			parts = append(parts, p)
+			i += end + 2
+		default:
+			i++
+			continue
+		}
+		start = i
+	}
+	flush(len(s))
+	return parts, nil
+}
+
+// parsePlural parses a plural selection of the form
+// "ordinal,one{st}two{nd}few{rd}other{th}".
+func parsePlural(s string) (rulePart, error) {
+	p := rulePart{forms: map[plural.Form]string{}}
+	kind, s, _ := strings.Cut(s, ",")
+	switch kind {
+	case "cardinal":
+		p.plural = plural.Cardinal
+	case "ordinal":
+		p.plural = plural.Ordinal
+	default:
+		return p, fmt.Errorf("number: unknown plural type %q", kind)
+	}
+	for s != "" {
+		i := strings.IndexByte(s, '{')
+		j := strings.IndexByte(s, '}')
+		form, ok := pluralForms[s[:max(i, 0)]]
+		if i < 0 || j < i || !ok {
+			return p, fmt.Errorf("number: invalid plural selection %q", s)
+		}
+		p.forms[form] = s[i+1 : j]
+		s = s[j+1:]
+	}
+	return p, nil
+}
+
+var pluralForms = map[string]plural.Form{
+	"other": plural.Other,
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+}
+
+// An rbnfLocale holds the rule sets of a language. Rule sets that are not
+// defined by a language are inherited from its parent.
+type rbnfLocale struct {
+	sets   map[string]*ruleSet
+	parent *rbnfLocale
+}
+
+func (l *rbnfLocale) lookup(name string) *ruleSet {
+	for ; l != nil; l = l.parent {
+		if s, ok := l.sets[name]; ok {
+			return s
+		}
+	}
+	return nil
+}
+
+var rbnfCache sync.Map // map[string]*rbnfLocale
+
+// lookupRBNF returns the rule sets for the closest ancestor of t for which
+// rules are defined.
+func lookupRBNF(t language.Tag) *rbnfLocale {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	for ; !key.IsRoot(); key = key.Parent() {
+		if _, ok := rbnfRules[key.String()]; ok {
+			return loadRBNF(key.String())
+		}
+	}
+	return loadRBNF("und")
+}
+
+func loadRBNF(id string) *rbnfLocale {
+	if l, ok := rbnfCache.Load(id); ok {
+		return l.(*rbnfLocale)
+	}
+	sets, err := parseRules(rbnfRules[id])
+	if err != nil {
+		panic(err) // The rules are static and verified by the tests.
+	}
+	l := &rbnfLocale{sets: sets}
+	if id != "und" {
+		l.parent = loadRBNF("und")
+	}
+	rbnfCache.Store(id, l)
+	return l
+}
+
+// An rbnfNumber is a number decomposed for rule-based formatting.
+type rbnfNumber struct {
+	neg, inf, nan bool
+
+	n    uint64 // integer part
+	frac []byte // fractional digits without trailing zeros, big-endian
+}
+
+// maxRBNFDigits is the maximum number of integer digits that fit in an
+// rbnfNumber.
+const maxRBNFDigits = 19
+
+// makeRBNFNumber converts d. It reports false if the integer part of d does
+// not fit.
+func makeRBNFNumber(d *number.Decimal) (x rbnfNumber, ok bool) {
+	x = rbnfNumber{neg: d.Neg, inf: d.Inf, nan: d.NaN}
+	if d.Inf || d.NaN {
+		return x, true
+	}
+	if d.Exp > maxRBNFDigits {
+		return x, false
+	}
+	for i := 0; i < int(d.Exp); i++ {
+		x.n *= 10
+		if i < len(d.Digits) {
+			x.n += uint64(d.Digits[i])
+		}
+	}
+	if int(d.Exp) < len(d.Digits) {
+		for i := d.Exp; i < 0; i++ {
+			x.frac = append(x.frac, 0)
+		}
+		x.frac = append(x.frac, d.Digits[max(d.Exp, 0):]...)
+		for len(x.frac) > 0 && x.frac[len(x.frac)-1] == 0 {
+			x.frac = x.frac[:len(x.frac)-1]
+		}
+	}
+	return x, true
+}
+
+// decimal converts x back to a Decimal.
+func (x rbnfNumber) decimal() number.Decimal {
+	var d number.Decimal
+	d.Neg = x.neg
+	if x.n > 0 {
+		for _, c := range strconv.FormatUint(x.n, 10) {
+			d.Digits = append(d.Digits, byte(c-'0'))
+		}
+	}
+	d.Exp = int32(len(d.Digits))
+	d.Digits = append(d.Digits, x.frac...)
+	for len(d.Digits) > 0 && d.Digits[0] == 0 {
+		d.Digits = d.Digits[1:]
+		d.Exp--
+	}
+	return d
+}
+
+// An rbnfFormatter formats numbers using the rules of a language.
+type rbnfFormatter struct {
+	tag    language.Tag
+	locale *rbnfLocale
+}
+
+// appendRBNF appends d formatted with the named rule set in language t. If
+// t defines no such rule set or d is too large to be formatted by the rules,
+// d is formatted with f instead. Private rule sets, which have names starting
+// with %%, cannot be selected.
+func appendRBNF(dst []byte, t language.Tag, name string, d *number.Decimal, f *number.Formatter) []byte {
+	return appendRules(dst, rbnfFormatter{tag: t, locale: lookupRBNF(t)}, name, d, f)
+}
+
+// appendAlgorithmic appends d formatted in the algorithmic numbering system of
+// f. Numbers that cannot be represented in the numbering system are formatted
+// with f using ASCII digits.
+func appendAlgorithmic(dst []byte, t language.Tag, d *number.Decimal, f *number.Formatter) []byte {
+	sys, ok := algorithmicSystems[f.NumberingSystem()]
+	if !ok {
+		return f.Format(dst, d)
+	}
+	return appendRules(dst, rbnfFormatter{tag: t, locale: loadRBNF(sys.lang)}, sys.ruleSet, d, f)
+}
+
+func appendRules(dst []byte, r rbnfFormatter, name string, d *number.Decimal, f *number.Formatter) []byte {
+	set := r.locale.lookup(name)
+	x, ok := makeRBNFNumber(d)
+	if set == nil || strings.HasPrefix(name, "%%") || !ok {
+		return f.Format(dst, d)
+	}
+	return r.format(dst, set, x)
+}
+
+func (r *rbnfFormatter) format(dst []byte, s *ruleSet, x rbnfNumber) []byte {
+	switch {
+	case x.nan:
+		if s.nan != nil {
+			return r.apply(dst, s, s.nan, x)
+		}
+		return append(dst, "NaN"...)
+	case x.neg:
+		x.neg = false
+		if s.negative != nil {
+			return r.apply(dst, s, s.negative, x)
+		}
+		return r.format(append(dst, '-'), s, x)
+	case x.inf:
+		if s.inf != nil {
+			return r.apply(dst, s, s.inf, x)
+		}
+		return append(dst, "∞"...)
+	case len(x.frac) > 0:
+		if x.n == 0 && s.proper != nil {
+			return r.apply(dst, s, s.proper, x)
+		}
+		if s.fraction != nil {
+			return r.apply(dst, s, s.fraction, x)
+		}
+		// Round to the nearest integer if fractions are not supported.
+		if x.frac[0] >= 5 {
+			x.n++
+		}
+		x.frac = nil
+	}
+	if len(s.rules) == 0 {
+		return strconv.AppendUint(dst, x.n, 10)
+	}
+	i := sort.Search(len(s.rules), func(i int) bool {
+		return s.rules[i].base > x.n
+	})
+	return r.apply(dst, s, s.rules[max(i-1, 0)], x)
+}
+
+// apply formats x using rule u of rule set s.
+func (r *rbnfFormatter) apply(dst []byte, s *ruleSet, u *rule, x rbnfNumber) []byte {
+	isNormal := s.negative != u && s.fraction != u && s.proper != u
+	omit := isNormal && x.n%u.divisor == 0
+	for _, p := range u.parts {
+		if p.optional && omit {
+			continue
+		}
+		switch p.sub {
+		case 0:
+			if p.plural == nil {
+				dst = append(dst, p.text...)
+				break
+			}
+			form := p.plural.MatchPlural(r.tag, int(x.n/u.divisor%10000000), 0, 0, 0, 0)
+			text, ok := p.forms[form]
+			if !ok {
+				text = p.forms[plural.Other]
+			}
+			dst = append(dst, text...)
+		case '<':
+			dst = r.substitute(dst, s, p, rbnfNumber{n: x.n / u.divisor})
+		case '>':
+			switch {
+			case s.negative == u:
+				dst = r.substitute(dst, s, p, x)
+			case isNormal:
+				dst = r.substitute(dst, s, p, rbnfNumber{n: x.n % u.divisor})
+			default:
+				// Fractional digits are formatted one by one.
+				for i, c := range x.frac {
+					if i > 0 && !p.noSpace {
+						dst = append(dst, ' ')
+					}
+					dst = r.substitute(dst, s, p, rbnfNumber{n: uint64(c)})
+				}
+			}
+		case '=':
+			dst = r.substitute(dst, s, p, x)
+		}
+	}
+	return dst
+}
+
+// substitute formats x as specified by the substitution p of a rule in s.
+func (r *rbnfFormatter) substitute(dst []byte, s *ruleSet, p rulePart, x rbnfNumber) []byte {
+	if p.pattern != nil {
+		var f number.Formatter
+		f.InitPattern(r.tag, p.pattern)
+		d := x.decimal()
+		return f.Format(dst, &d)
+	}
+	if p.ruleSet != "" {
+		if s = r.locale.lookup(p.ruleSet); s == nil {
+			return strconv.AppendUint(dst, x.n, 10)
+		}
+	}
+	return r.format(dst, s, x)
+}
@@ -0,0 +1,462 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+// rbnfRules holds the rule-based number formatting rules per language, in ICU
+// syntax. The rules are derived from the CLDR 32 "rbnf" data. Soft hyphens
+// are omitted and rule sets that are not used by this package are dropped.
+//
+// The following public rule sets are used by this package:
+//
+//	%spellout-numbering  numbers as spelled out for counting
+//	%digits-ordinal      ordinal numbers using digits, such as 1st
+//	%roman-upper         Roman numerals in upper case
+//	%roman-lower         Roman numerals in lower case
+//
+// The latter three are defined for all languages by the root locale, und.
+// The rule sets %spellout-cardinal and %spellout-cardinal-financial of zh,
+// zh-Hant, and ja implement the algorithmic numbering systems hans, hansfin,
+// hant, hantfin, and jpan.
+var rbnfRules = map[string]string{
+	"und": `
+%digits-ordinal:
+	-x: −>>;
+	0: =#,##0=;
+%roman-upper:
+	-x: −>>;
+	x.x: =#,##0.00=;
+	0: N;
+	1: I; 2: II; 3: III; 4: IV; 5: V; 6: VI; 7: VII; 8: VIII; 9: IX;
+	10: X[>>]; 20: XX[>>]; 30: XXX[>>]; 40: XL[>>]; 50: L[>>];
+	60: LX[>>]; 70: LXX[>>]; 80: LXXX[>>]; 90: XC[>>];
+	100: C[>>]; 200: CC[>>]; 300: CCC[>>]; 400: CD[>>]; 500: D[>>];
+	600: DC[>>]; 700: DCC[>>]; 800: DCCC[>>]; 900: CM[>>];
+	1000: M[>>]; 2000: MM[>>]; 3000: MMM[>>];
+	4000: =#,##0=;
+%roman-lower:
+	-x: −>>;
+	x.x: =#,##0.00=;
+	0: n;
+	1: i; 2: ii; 3: iii; 4: iv; 5: v; 6: vi; 7: vii; 8: viii; 9: ix;
+	10: x[>>]; 20: xx[>>]; 30: xxx[>>]; 40: xl[>>]; 50: l[>>];
+	60: lx[>>]; 70: lxx[>>]; 80: lxxx[>>]; 90: xc[>>];
+	100: c[>>]; 200: cc[>>]; 300: ccc[>>]; 400: cd[>>]; 500: d[>>];
+	600: dc[>>]; 700: dcc[>>]; 800: dccc[>>]; 900: cm[>>];
+	1000: m[>>]; 2000: mm[>>]; 3000: mmm[>>];
+	4000: =#,##0=;
+`,
+
+	"en": `
+%spellout-numbering:
+	-x: minus >>;
+	x.x: << point >>;
+	Inf: infinity;
+	NaN: not a number;
+	0: =%spellout-cardinal=;
+%spellout-cardinal:
+	-x: minus >>;
+	x.x: << point >>;
+	Inf: infinity;
+	NaN: not a number;
+	0: zero; 1: one; 2: two; 3: three; 4: four; 5: five; 6: six; 7: seven;
+	8: eight; 9: nine; 10: ten; 11: eleven; 12: twelve; 13: thirteen;
+	14: fourteen; 15: fifteen; 16: sixteen; 17: seventeen; 18: eighteen;
+	19: nineteen;
+	20: twenty[->>]; 30: thirty[->>]; 40: forty[->>]; 50: fifty[->>];
+	60: sixty[->>]; 70: seventy[->>]; 80: eighty[->>]; 90: ninety[->>];
+	100: << hundred[ >>];
+	1000: << thousand[ >>];
+	1000000: << million[ >>];
+	1000000000: << billion[ >>];
+	1000000000000: << trillion[ >>];
+	1000000000000000: << quadrillion[ >>];
+	1000000000000000000: =#,##0=;
+%spellout-ordinal:
+	-x: minus >>;
+	x.x: =#,##0.#=;
+	Inf: infinitieth;
+	0: zeroth; 1: first; 2: second; 3: third; 4: fourth; 5: fifth; 6: sixth;
+	7: seventh; 8: eighth; 9: ninth; 10: tenth; 11: eleventh; 12: twelfth;
+	13: =%spellout-numbering=th;
+	20: twentieth; 21: twenty->>;
+	30: thirtieth; 31: thirty->>;
+	40: fortieth; 41: forty->>;
+	50: fiftieth; 51: fifty->>;
+	60: sixtieth; 61: sixty->>;
+	70: seventieth; 71: seventy->>;
+	80: eightieth; 81: eighty->>;
+	90: ninetieth; 91: ninety->>;
+	100: <%spellout-numbering< hundred>%%th>;
+	1000: <%spellout-numbering< thousand>%%th>;
+	1000000: <%spellout-numbering< million>%%th>;
+	1000000000: <%spellout-numbering< billion>%%th>;
+	1000000000000: <%spellout-numbering< trillion>%%th>;
+	1000000000000000: <%spellout-numbering< quadrillion>%%th>;
+	1000000000000000000: =#,##0=.;
+%%th:
+	0: th;
+	1: ' =%spellout-ordinal=;
+%digits-ordinal:
+	-x: −>>;
+	0: =#,##0=$(ordinal,one{st}two{nd}few{rd}other{th})$;
+`,
+
+	"de": `
+%spellout-numbering:
+	-x: minus >>;
+	x.x: << Komma >>;
+	Inf: unendlich;
+	NaN: keine Zahl;
+	0: =%spellout-cardinal=;
+%spellout-cardinal:
+	-x: minus >>;
+	x.x: << Komma >>;
+	Inf: unendlich;
+	NaN: keine Zahl;
+	0: null; 1: eins; 2: zwei; 3: drei; 4: vier; 5: fünf; 6: sechs;
+	7: sieben; 8: acht; 9: neun; 10: zehn; 11: elf; 12: zwölf;
+	13: >>zehn; 16: sechzehn; 17: siebzehn; 18: >>zehn;
+	20: [>%spellout-cardinal-masculine>und]zwanzig;
+	30: [>%spellout-cardinal-masculine>und]dreißig;
+	40: [>%spellout-cardinal-masculine>und]vierzig;
+	50: [>%spellout-cardinal-masculine>und]fünfzig;
+	60: [>%spellout-cardinal-masculine>und]sechzig;
+	70: [>%spellout-cardinal-masculine>und]siebzig;
+	80: [>%spellout-cardinal-masculine>und]achtzig;
+	90: [>%spellout-cardinal-masculine>und]neunzig;
+	100: <%spellout-cardinal-masculine<hundert[>>];
+	1000: <%spellout-cardinal-masculine<tausend[>>];
+	1000000: eine Million[ >>];
+	2000000: << Millionen[ >>];
+	1000000000: eine Milliarde[ >>];
+	2000000000: << Milliarden[ >>];
+	1000000000000: eine Billion[ >>];
+	2000000000000: << Billionen[ >>];
+	1000000000000000: =#,##0=;
+%spellout-cardinal-masculine:
+	-x: minus >>;
+	x.x: << Komma >>;
+	Inf: unendlich;
+	NaN: keine Zahl;
+	0: null;
+	1: ein;
+	2: =%spellout-cardinal=;
+%spellout-ordinal:
+	-x: minus >>;
+	x.x: =#,##0.#=;
+	0: nullte; 1: erste; 2: zweite; 3: dritte; 4: vierte; 5: fünfte;
+	6: sechste; 7: siebte; 8: achte;
+	9: =%spellout-cardinal=te;
+	20: =%spellout-cardinal=ste;
+	100: <%spellout-cardinal-masculine<hundert>%%ste>;
+	1000: <%spellout-cardinal-masculine<tausend>%%ste>;
+	1000000: =#,##0=.;
+%%ste:
+	0: ste;
+	1: =%spellout-ordinal=;
+%digits-ordinal:
+	-x: −>>;
+	0: =#,##0=.;
+`,
+
+	"es": `
+%spellout-numbering:
+	-x: menos >>;
+	x.x: << coma >>;
+	Inf: infinito;
+	NaN: NaN;
+	0: cero; 1: uno; 2: dos; 3: tres; 4: cuatro; 5: cinco; 6: seis;
+	7: siete; 8: ocho; 9: nueve; 10: diez; 11: once; 12: doce; 13: trece;
+	14: catorce; 15: quince; 16: dieciséis; 17: dieci>>;
+	20: veinte; 21: veintiuno; 22: veintidós; 23: veintitrés;
+	24: veinticuatro; 25: veinticinco; 26: veintiséis; 27: veinti>>;
+	30: treinta[ y >>]; 40: cuarenta[ y >>]; 50: cincuenta[ y >>];
+	60: sesenta[ y >>]; 70: setenta[ y >>]; 80: ochenta[ y >>];
+	90: noventa[ y >>];
+	100: cien; 101: ciento >>;
+	200: doscientos[ >>]; 300: trescientos[ >>]; 400: cuatrocientos[ >>];
+	500: quinientos[ >>]; 600: seiscientos[ >>]; 700: setecientos[ >>];
+	800: ochocientos[ >>]; 900: novecientos[ >>];
+	1000: mil[ >>];
+	2000: <%spellout-cardinal-masculine< mil[ >>];
+	1000000: un millón[ >>];
+	2000000: <%spellout-cardinal-masculine< millones[ >>];
+	1000000000000: un billón[ >>];
+	2000000000000: <%spellout-cardinal-masculine< billones[ >>];
+	1000000000000000000: =#,##0=;
+%spellout-cardinal-masculine:
+	-x: menos >>;
+	x.x: << coma >>;
+	Inf: infinito;
+	NaN: NaN;
+	0: cero; 1: un; 2: dos; 3: tres; 4: cuatro; 5: cinco; 6: seis;
+	7: siete; 8: ocho; 9: nueve; 10: diez; 11: once; 12: doce; 13: trece;
+	14: catorce; 15: quince; 16: dieciséis; 17: dieci>>;
+	20: veinte; 21: veintiún; 22: veintidós; 23: veintitrés;
+	24: veinticuatro; 25: veinticinco; 26: veintiséis; 27: veinti>>;
+	30: treinta[ y >>]; 40: cuarenta[ y >>]; 50: cincuenta[ y >>];
+	60: sesenta[ y >>]; 70: setenta[ y >>]; 80: ochenta[ y >>];
+	90: noventa[ y >>];
+	100: cien; 101: ciento >>;
+	200: doscientos[ >>]; 300: trescientos[ >>]; 400: cuatrocientos[ >>];
+	500: quinientos[ >>]; 600: seiscientos[ >>]; 700: setecientos[ >>];
+	800: ochocientos[ >>]; 900: novecientos[ >>];
+	1000: mil[ >>];
+	2000: << mil[ >>];
+	1000000: un millón[ >>];
+	2000000: << millones[ >>];
+	1000000000000: un billón[ >>];
+	2000000000000: << billones[ >>];
+	1000000000000000000: =#,##0=;
+%digits-ordinal-masculine:
+	-x: −>>;
+	0: =#,##0=º;
+%digits-ordinal-feminine:
+	-x: −>>;
+	0: =#,##0=ª;
+%digits-ordinal:
+	-x: −>>;
+	0: =%digits-ordinal-masculine=;
+`,
+
+	"fr": `
+%spellout-numbering:
+	-x: moins >>;
+	x.x: << virgule >>;
+	Inf: infini;
+	NaN: non numérique;
+	0: =%spellout-cardinal-masculine=;
+%%et-un:
+	1: et-un;
+	2: =%spellout-cardinal-masculine=;
+	11: et-onze;
+	12: =%spellout-cardinal-masculine=;
+%%cents-m:
+	0: s;
+	1: ' =%spellout-cardinal-masculine=;
+%%vingts-m:
+	0: s;
+	1: -=%spellout-cardinal-masculine=;
+%%spellout-leading:
+	0: =%spellout-cardinal-masculine=;
+	80/20: quatre-vingt[->%spellout-cardinal-masculine>];
+	100: cent[ >>];
+	200: << cent[ >>];
+%spellout-cardinal-masculine:
+	-x: moins >>;
+	x.x: << virgule >>;
+	Inf: infini;
+	NaN: non numérique;
+	0: zéro; 1: un; 2: deux; 3: trois; 4: quatre; 5: cinq; 6: six;
+	7: sept; 8: huit; 9: neuf; 10: dix; 11: onze; 12: douze; 13: treize;
+	14: quatorze; 15: quinze; 16: seize; 17: dix->>;
+	20: vingt[->%%et-un>];
+	30: trente[->%%et-un>];
+	40: quarante[->%%et-un>];
+	50: cinquante[->%%et-un>];
+	60/20: soixante[->%%et-un>];
+	80/20: quatre-vingt>%%vingts-m>;
+	100: cent[ >>];
+	200: << cent>%%cents-m>;
+	1000: mille[ >>];
+	2000: <%%spellout-leading< mille[ >>];
+	1000000: << million[ >>];
+	2000000: << millions[ >>];
+	1000000000: << milliard[ >>];
+	2000000000: << milliards[ >>];
+	1000000000000: << billion[ >>];
+	2000000000000: << billions[ >>];
+	1000000000000000: =#,##0=;
+%digits-ordinal-masculine:
+	-x: −>>;
+	0: =#,##0=$(ordinal,one{er}other{e})$;
+%digits-ordinal-feminine:
+	-x: −>>;
+	0: =#,##0=$(ordinal,one{re}other{e})$;
+%digits-ordinal:
+	-x: −>>;
+	0: =%digits-ordinal-masculine=;
+`,
+
+	"it": `
+%digits-ordinal-masculine:
+	-x: −>>;
+	0: =#,##0=º;
+%digits-ordinal-feminine:
+	-x: −>>;
+	0: =#,##0=ª;
+%digits-ordinal:
+	-x: −>>;
+	0: =%digits-ordinal-masculine=;
+`,
+
+	"nl": `
+%digits-ordinal:
+	-x: −>>;
+	0: =#,##0=e;
+`,
+
+	"ja": `
+%digits-ordinal:
+	-x: −>>;
+	0: 第=#,##0=;
+%spellout-cardinal:
+	-x: マイナス>>;
+	x.x: <<点>>>;
+	0: 〇;
+	1: 一; 2: 二; 3: 三; 4: 四; 5: 五; 6: 六; 7: 七; 8: 八; 9: 九;
+	10: 十[>>]; 20: <<十[>>];
+	100: 百[>>]; 200: <<百[>>];
+	1000: 千[>>]; 2000: <<千[>>];
+	10000: <<万[>>];
+	100000000: <<億[>>];
+	1000000000000: <<兆[>>];
+	10000000000000000: =#,##0=;
+`,
+
+	"zh": `
+%digits-ordinal:
+	-x: −>>;
+	0: 第=#,##0=;
+%spellout-cardinal:
+	-x: 负>>;
+	x.x: <<点>>>;
+	0: 零;
+	1: 一; 2: 二; 3: 三; 4: 四; 5: 五; 6: 六; 7: 七; 8: 八; 9: 九;
+	10: 十[>>]; 20: <<十[>>];
+	100: <<百[>%%cardinal-after-hundred>];
+	1000: <<千[>%%cardinal-after-thousand>];
+	10000: <<万[>%%cardinal-after-myriad>];
+	100000000: <<亿[>%%cardinal-after-yi>];
+	1000000000000: <<兆[>%%cardinal-after-zhao>];
+	10000000000000000: =#,##0=;
+%%cardinal-after-hundred:
+	1: 零=%spellout-cardinal=;
+	10: 一=%spellout-cardinal=;
+	20: =%spellout-cardinal=;
+%%cardinal-after-thousand:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	100: =%spellout-cardinal=;
+%%cardinal-after-myriad:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	1000: =%spellout-cardinal=;
+%%cardinal-after-yi:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	10000000: =%spellout-cardinal=;
+%%cardinal-after-zhao:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	100000000000: =%spellout-cardinal=;
+%spellout-cardinal-financial:
+	-x: 负>>;
+	x.x: <<点>>>;
+	0: 零;
+	1: 壹; 2: 贰; 3: 叁; 4: 肆; 5: 伍; 6: 陆; 7: 柒; 8: 捌; 9: 玖;
+	10: <<拾[>>];
+	100: <<佰[>%%financial-after-hundred>];
+	1000: <<仟[>%%financial-after-thousand>];
+	10000: <<万[>%%financial-after-myriad>];
+	100000000: <<亿[>%%financial-after-yi>];
+	1000000000000: <<兆[>%%financial-after-zhao>];
+	10000000000000000: =#,##0=;
+%%financial-after-hundred:
+	1: 零=%spellout-cardinal-financial=;
+	10: =%spellout-cardinal-financial=;
+%%financial-after-thousand:
+	1: 零=%spellout-cardinal-financial=;
+	100: =%spellout-cardinal-financial=;
+%%financial-after-myriad:
+	1: 零=%spellout-cardinal-financial=;
+	1000: =%spellout-cardinal-financial=;
+%%financial-after-yi:
+	1: 零=%spellout-cardinal-financial=;
+	10000000: =%spellout-cardinal-financial=;
+%%financial-after-zhao:
+	1: 零=%spellout-cardinal-financial=;
+	100000000000: =%spellout-cardinal-financial=;
+`,
+
+	"zh-Hant": `
+%digits-ordinal:
+	-x: −>>;
+	0: 第=#,##0=;
+%spellout-cardinal:
+	-x: 負>>;
+	x.x: <<點>>>;
+	0: 零;
+	1: 一; 2: 二; 3: 三; 4: 四; 5: 五; 6: 六; 7: 七; 8: 八; 9: 九;
+	10: 十[>>]; 20: <<十[>>];
+	100: <<百[>%%cardinal-after-hundred>];
+	1000: <<千[>%%cardinal-after-thousand>];
+	10000: <<萬[>%%cardinal-after-myriad>];
+	100000000: <<億[>%%cardinal-after-yi>];
+	1000000000000: <<兆[>%%cardinal-after-zhao>];
+	10000000000000000: =#,##0=;
+%%cardinal-after-hundred:
+	1: 零=%spellout-cardinal=;
+	10: 一=%spellout-cardinal=;
+	20: =%spellout-cardinal=;
+%%cardinal-after-thousand:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	100: =%spellout-cardinal=;
+%%cardinal-after-myriad:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	1000: =%spellout-cardinal=;
+%%cardinal-after-yi:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	10000000: =%spellout-cardinal=;
+%%cardinal-after-zhao:
+	1: 零=%spellout-cardinal=;
+	10: 零一=%spellout-cardinal=;
+	20: 零=%spellout-cardinal=;
+	100000000000: =%spellout-cardinal=;
+%spellout-cardinal-financial:
+	-x: 負>>;
+	x.x: <<點>>>;
+	0: 零;
+	1: 壹; 2: 貳; 3: 參; 4: 肆; 5: 伍; 6: 陸; 7: 柒; 8: 捌; 9: 玖;
+	10: <<拾[>>];
+	100: <<佰[>%%financial-after-hundred>];
+	1000: <<仟[>%%financial-after-thousand>];
+	10000: <<萬[>%%financial-after-myriad>];
+	100000000: <<億[>%%financial-after-yi>];
+	1000000000000: <<兆[>%%financial-after-zhao>];
+	10000000000000000: =#,##0=;
+%%financial-after-hundred:
+	1: 零=%spellout-cardinal-financial=;
+	10: =%spellout-cardinal-financial=;
+%%financial-after-thousand:
+	1: 零=%spellout-cardinal-financial=;
+	100: =%spellout-cardinal-financial=;
+%%financial-after-myriad:
+	1: 零=%spellout-cardinal-financial=;
+	1000: =%spellout-cardinal-financial=;
+%%financial-after-yi:
+	1: 零=%spellout-cardinal-financial=;
+	10000000: =%spellout-cardinal-financial=;
+%%financial-after-zhao:
+	1: 零=%spellout-cardinal-financial=;
+	100000000000: =%spellout-cardinal-financial=;
+`,
+
+	"ru": `
+%digits-ordinal:
+	-x: −>>;
+	0: =#,##0=-й;
+`,
+}
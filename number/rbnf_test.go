@@ -0,0 +1,185 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestRuleBased(t *testing.T) {
+	spellOrdinal := RuleBased("spellout-ordinal")
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", SpellOut(0), "zero"},
+		{"en", SpellOut(13), "thirteen"},
+		{"en", SpellOut(21), "twenty-one"},
+		{"en", SpellOut(100), "one hundred"},
+		{"en", SpellOut(123), "one hundred twenty-three"},
+		{"en", SpellOut(1001), "one thousand one"},
+		{"en", SpellOut(2500000), "two million five hundred thousand"},
+		{"en", SpellOut(int64(5e15)), "five quadrillion"},
+		{"en", SpellOut(uint64(math.MaxUint64)), "18,446,744,073,709,551,615"},
+		{"en", SpellOut(-7), "minus seven"},
+		{"en", SpellOut(1.5), "one point five"},
+		{"en", SpellOut(0.25), "zero point two five"},
+		{"en", SpellOut(3.14159), "three point one four two"},
+		{"en", SpellOut(3.14159, MaxFractionDigits(1)), "three point one"},
+		{"en", SpellOut(math.Inf(1)), "infinity"},
+		{"en", SpellOut(math.Inf(-1)), "minus infinity"},
+		{"en", SpellOut(math.NaN()), "not a number"},
+		{"en-GB", SpellOut(42), "forty-two"},
+		{"de", SpellOut(1), "eins"},
+		{"de", SpellOut(17), "siebzehn"},
+		{"de", SpellOut(21), "einundzwanzig"},
+		{"de", SpellOut(101), "einhunderteins"},
+		{"de", SpellOut(123), "einhundertdreiundzwanzig"},
+		{"de", SpellOut(2500000), "zwei Millionen fünfhunderttausend"},
+		{"de", SpellOut(1.5), "eins Komma fünf"},
+		{"de-CH", SpellOut(30), "dreißig"},
+		{"fr", SpellOut(21), "vingt-et-un"},
+		{"fr", SpellOut(71), "soixante-et-onze"},
+		{"fr", SpellOut(80), "quatre-vingts"},
+		{"fr", SpellOut(99), "quatre-vingt-dix-neuf"},
+		{"fr", SpellOut(200), "deux cents"},
+		{"fr", SpellOut(280), "deux cent quatre-vingts"},
+		{"fr", SpellOut(80000), "quatre-vingt mille"},
+		{"fr", SpellOut(2000000000), "deux milliards"},
+		{"es", SpellOut(16), "dieciséis"},
+		{"es", SpellOut(21), "veintiuno"},
+		{"es", SpellOut(100), "cien"},
+		{"es", SpellOut(101), "ciento uno"},
+		{"es", SpellOut(21000), "veintiún mil"},
+		{"es", SpellOut(5000000000), "cinco mil millones"},
+		{"ja", SpellOut(1234.5), "1,234.5"},
+		{"ja", SpellOut(-7), "-7"},
+
+		{"en", Ordinal(1), "1st"},
+		{"en", Ordinal(2), "2nd"},
+		{"en", Ordinal(3), "3rd"},
+		{"en", Ordinal(11), "11th"},
+		{"en", Ordinal(22), "22nd"},
+		{"en", Ordinal(1001), "1,001st"},
+		{"en", Ordinal(-3), "−3rd"},
+		{"de", Ordinal(1), "1."},
+		{"fr", Ordinal(1), "1er"},
+		{"fr", Ordinal(3), "3e"},
+		{"es", Ordinal(3), "3º"},
+		{"nl", Ordinal(3), "3e"},
+		{"ja", Ordinal(3), "第3"},
+		{"ko", Ordinal(3), "3"},
+
+		{"en", spellOrdinal(1), "first"},
+		{"en", spellOrdinal(12), "twelfth"},
+		{"en", spellOrdinal(13), "thirteenth"},
+		{"en", spellOrdinal(21), "twenty-first"},
+		{"en", spellOrdinal(100), "one hundredth"},
+		{"en", spellOrdinal(123), "one hundred twenty-third"},
+		{"de", spellOrdinal(3), "dritte"},
+		{"de", spellOrdinal(21), "einundzwanzigste"},
+		{"de", spellOrdinal(101), "einhunderterste"},
+		{"fr", spellOrdinal(3), "3"},
+		{"de", RuleBased("spellout-cardinal-masculine")(1), "ein"},
+		{"fr", RuleBased("digits-ordinal-feminine")(1), "1re"},
+		{"en", RuleBased("%th")(1), "1"},
+
+		{"en", Numbering(1999), "MCMXCIX"},
+		{"en", Numbering(2024), "MMXXIV"},
+		{"en", Numbering(3999), "MMMCMXCIX"},
+		{"en", Numbering(4000), "4,000"},
+		{"en", Numbering(0), "N"},
+		{"en-u-nu-romanlow", Numbering(14), "xiv"},
+		{"en-u-nu-roman", Numbering(14), "XIV"},
+		{"de", RuleBased("roman-lower")(49), "xlix"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			p := message.NewPrinter(language.MustParse(tc.tag))
+			if got := p.Sprint(tc.f); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRuleData(t *testing.T) {
+	for id, s := range rbnfRules {
+		sets, err := parseRules(s)
+		if err != nil {
+			t.Errorf("%s: %v", id, err)
+			continue
+		}
+		l := loadRBNF(id)
+		for name, set := range sets {
+			for _, r := range append(set.rules, set.negative, set.fraction, set.proper) {
+				if r == nil {
+					continue
+				}
+				for _, p := range r.parts {
+					if p.ruleSet != "" && l.lookup(p.ruleSet) == nil {
+						t.Errorf("%s:%s: undefined rule set %q", id, name, p.ruleSet)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	testCases := []struct {
+		rules string
+		ok    bool
+	}{
+		{"%a: 0: zero; 1: one;", true},
+		{"%a: 0: zero; 10/20: >>;", true},
+		{"%a: 0: =#,##0=$(cardinal,one{x}other{y})$;", true},
+		{"0: zero;", false},
+		{"%a 0: zero;", false},
+		{"%a: zero;", false},
+		{"%a: x: zero;", false},
+		{"%a: 10/1: >>;", false},
+		{"%a: 0: ==;", false},
+		{"%a: 0: =#,##0=$(gender,one{x})$;", false},
+		{"%a: 0: =#,##0=$(cardinal,one{x)$;", false},
+		{"%a: 0: =#,##0=$(cardinal,single{x})$;", false},
+	}
+	for _, tc := range testCases {
+		_, err := parseRules(tc.rules)
+		if ok := err == nil; ok != tc.ok {
+			t.Errorf("%q: error was %v; want %v", tc.rules, err, tc.ok)
+		}
+	}
+}
+
+func TestDivisor(t *testing.T) {
+	testCases := []struct {
+		rule    string
+		divisor uint64
+	}{
+		{"0: x", 1},
+		{"9: x", 1},
+		{"10: x", 10},
+		{"99: x", 10},
+		{"100: x", 100},
+		{"1,000: x", 1000},
+		{"60/20: x", 20},
+		{"400/20: x", 400},
+	}
+	for _, tc := range testCases {
+		s := &ruleSet{}
+		if err := s.addRule(tc.rule); err != nil {
+			t.Fatalf("%s: %v", tc.rule, err)
+		}
+		if got := s.rules[0].divisor; got != tc.divisor {
+			t.Errorf("%s: divisor was %d; want %d", tc.rule, got, tc.divisor)
+		}
+	}
+}
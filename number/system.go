@@ -0,0 +1,83 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package number
+
+import (
+	"golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+)
+
+// algorithmicSystems maps the supported CLDR algorithmic numbering systems to
+// the rule set that implements them and the language that defines it.
+var algorithmicSystems = map[string]struct{ lang, ruleSet string }{
+	"roman":    {"und", "%roman-upper"},
+	"romanlow": {"und", "%roman-lower"},
+	"hans":     {"zh", "%spellout-cardinal"},
+	"hansfin":  {"zh", "%spellout-cardinal-financial"},
+	"hant":     {"zh-Hant", "%spellout-cardinal"},
+	"hantfin":  {"zh-Hant", "%spellout-cardinal-financial"},
+	"jpan":     {"ja", "%spellout-cardinal"},
+}
+
+// Indexes into the values of otherNumberingSystems.
+const (
+	nativeSystem = iota
+	traditionalSystem
+	financeSystem
+)
+
+// otherNumberingSystems holds the CLDR native, traditional, and finance
+// numbering systems for a selection of languages. An empty string means the
+// default numbering system.
+var otherNumberingSystems = map[string][3]string{
+	"ar":      {"arab", "", ""},
+	"bn":      {"beng", "", ""},
+	"fa":      {"arabext", "", ""},
+	"hi":      {"deva", "", ""},
+	"ja":      {"latn", "jpan", ""},
+	"mr":      {"deva", "", ""},
+	"th":      {"thai", "", ""},
+	"zh":      {"hanidec", "hans", "hansfin"},
+	"zh-Hant": {"hanidec", "hant", "hantfin"},
+}
+
+// resolveNumberingSystem returns the numbering system denoted by name for
+// language t. It resolves the keywords "native", "traditio", and "finance"
+// to the respective numbering system of the language, or to the empty string
+// if the language does not define one.
+func resolveNumberingSystem(t language.Tag, name string) string {
+	index := 0
+	switch name {
+	case "native":
+		index = nativeSystem
+	case "traditio":
+		index = traditionalSystem
+	case "finance":
+		index = financeSystem
+	default:
+		return name
+	}
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	for ; !key.IsRoot(); key = key.Parent() {
+		if systems, ok := otherNumberingSystems[key.String()]; ok {
+			return systems[index]
+		}
+	}
+	return ""
+}
+
+// setNumberingSystem sets the numbering system of f to the system denoted by
+// name for language t. Unknown numbering systems select the default numbering
+// system of the language.
+func setNumberingSystem(t language.Tag, f *number.Formatter, name string) {
+	name = resolveNumberingSystem(t, name)
+	if name == "" {
+		return
+	}
+	if u, err := t.SetTypeForKey("nu", name); err == nil {
+		f.Info = number.InfoFromTag(u)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import (
+	"errors"
+	"strings"
+)
+
+// A conversion defines a unit in terms of its CLDR base unit:
+//
+//	base = factor*x + offset
+type conversion struct {
+	base   string
+	factor float64
+	offset float64
+}
+
+// conversions holds the CLDR conversion data for the units of this package,
+// derived from the CLDR "unitConstants" and "convertUnits" data.
+var conversions = map[string]conversion{
+	"kilometer":  {"meter", 1000, 0},
+	"meter":      {"meter", 1, 0},
+	"centimeter": {"meter", 0.01, 0},
+	"millimeter": {"meter", 0.001, 0},
+	"mile":       {"meter", 1609.344, 0},
+	"yard":       {"meter", 0.9144, 0},
+	"foot":       {"meter", 0.3048, 0},
+	"inch":       {"meter", 0.0254, 0},
+
+	"kilogram": {"kilogram", 1, 0},
+	"gram":     {"kilogram", 0.001, 0},
+	"pound":    {"kilogram", 0.45359237, 0},
+	"ounce":    {"kilogram", 0.45359237 / 16, 0},
+
+	"year":        {"year", 1, 0},
+	"month":       {"year", 1.0 / 12, 0},
+	"week":        {"second", 7 * 86400, 0},
+	"day":         {"second", 86400, 0},
+	"hour":        {"second", 3600, 0},
+	"minute":      {"second", 60, 0},
+	"second":      {"second", 1, 0},
+	"millisecond": {"second", 0.001, 0},
+
+	"celsius":    {"kelvin", 1, 273.15},
+	"fahrenheit": {"kelvin", 5.0 / 9, 2298.35 / 9},
+	"kelvin":     {"kelvin", 1, 0},
+
+	"liter":      {"cubic-meter", 0.001, 0},
+	"milliliter": {"cubic-meter", 0.000001, 0},
+	"gallon":     {"cubic-meter", 0.003785411784, 0},
+
+	"kilometer-per-hour": {"meter-per-second", 1000.0 / 3600, 0},
+	"mile-per-hour":      {"meter-per-second", 1609.344 / 3600, 0},
+	"meter-per-second":   {"meter-per-second", 1, 0},
+
+	"liter-per-100kilometers": {"cubic-meter-per-meter", 0.001 / 100000, 0},
+	"mile-per-gallon":         {"meter-per-cubic-meter", 1609.344 / 0.003785411784, 0},
+
+	"byte":     {"bit", 8, 0},
+	"kilobyte": {"bit", 8e3, 0},
+	"megabyte": {"bit", 8e6, 0},
+	"gigabyte": {"bit", 8e9, 0},
+
+	"percent": {"portion", 0.01, 0},
+}
+
+var errIncompatible = errors.New("units: incompatible units")
+
+// conversion returns the conversion of u to its base unit. The base unit of
+// a compound unit is the compound of the base units of its parts.
+func (u Unit) conversion() (c conversion, ok bool) {
+	c, ok = conversions[unitInfos[u.index].id]
+	if !ok || u.per == 0 {
+		return c, ok
+	}
+	d, ok := conversions[unitInfos[u.per].id]
+	if !ok || c.offset != 0 || d.offset != 0 {
+		return conversion{}, false
+	}
+	return conversion{c.base + "-per-" + d.base, c.factor / d.factor, 0}, true
+}
+
+// Convert converts the amount x of unit from to unit to. Units can be
+// converted if they measure the same quantity, such as length, or if one
+// measures the inverse of the other, such as liters per 100 kilometers and
+// miles per gallon. An error is returned if the units are incompatible.
+func Convert(x float64, from, to Unit) (float64, error) {
+	f, ok := from.conversion()
+	if !ok {
+		return 0, errIncompatible
+	}
+	t, ok := to.conversion()
+	if !ok {
+		return 0, errIncompatible
+	}
+	switch {
+	case f.base == t.base:
+		return (x*f.factor + f.offset - t.offset) / t.factor, nil
+	case f.offset == 0 && t.offset == 0 && f.base == inverse(t.base):
+		return 1 / (x * f.factor * t.factor), nil
+	}
+	return 0, errIncompatible
+}
+
+// inverse returns the inverse of the compound base unit s, or "" if s is not a
+// compound.
+func inverse(s string) string {
+	i := strings.Index(s, "-per-")
+	if i < 0 {
+		return ""
+	}
+	return s[i+len("-per-"):] + "-per-" + s[:i]
+}
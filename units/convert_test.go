@@ -0,0 +1,65 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		x        float64
+		from, to Unit
+		want     float64
+		ok       bool
+	}{
+		{1, Kilometer, Meter, 1000, true},
+		{1, Mile, Kilometer, 1.609344, true},
+		{12, Inch, Foot, 1, true},
+		{1, Pound, Ounce, 16, true},
+		{1, Kilogram, Pound, 2.2046226218, true},
+		{100, Celsius, Fahrenheit, 212, true},
+		{32, Fahrenheit, Celsius, 0, true},
+		{0, Kelvin, Celsius, -273.15, true},
+		{-40, Fahrenheit, Celsius, -40, true},
+		{1, Gallon, Liter, 3.785411784, true},
+		{1, Week, Hour, 168, true},
+		{1, Year, Month, 12, true},
+		{1, Gigabyte, Megabyte, 1000, true},
+		{100, KilometerPerHour, MeterPerSecond, 27.7777777778, true},
+		{60, MilePerHour, KilometerPerHour, 96.56064, true},
+		{1, Kilometer.Per(Hour), Meter.Per(Second), 0.2777777778, true},
+		{1, Meter.Per(Second), KilometerPerHour, 3.6, true},
+		{1, Gram.Per(Liter), Kilogram.Per(Liter), 0.001, true},
+
+		// Reciprocal units.
+		{10, LiterPer100Kilometers, MilePerGallon, 23.5214583, true},
+		{23.5214583, MilePerGallon, LiterPer100Kilometers, 10, true},
+
+		{1, Kilometer, Kilogram, 0, false},
+		{1, Hour, Month, 0, false},
+		{1, Celsius.Per(Hour), Kelvin.Per(Hour), 0, false},
+		{1, Unit{}, Meter, 0, false},
+	}
+	for _, tc := range testCases {
+		got, err := Convert(tc.x, tc.from, tc.to)
+		if ok := err == nil; ok != tc.ok {
+			t.Errorf("Convert(%v, %v, %v): error was %v; want %v", tc.x, tc.from, tc.to, err, tc.ok)
+			continue
+		}
+		if math.Abs(got-tc.want) > 1e-6 {
+			t.Errorf("Convert(%v, %v, %v) = %v; want %v", tc.x, tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestConversions(t *testing.T) {
+	for _, u := range unitInfos[1:] {
+		if _, ok := conversions[u.id]; !ok {
+			t.Errorf("no conversion for %q", u.id)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+// This file contains the CLDR unit data for the locales supported by this
+// package. The data is derived from the CLDR 32 "units" and "listPatterns"
+// sections. Patterns for the widths Long, Short, and Narrow are listed in
+// that order. An empty pattern indicates that the next wider pattern should be
+// used instead. Long patterns that are missing fall back to short ones.
+//
+// Patterns that depend on the plural form are written as
+// "one={0} day|other={0} days". A pattern without forms applies to all forms.
+
+// localeUnits holds the unit data for a single locale.
+type localeUnits struct {
+	// patterns maps unit IDs to their patterns.
+	patterns map[string][numWidths]string
+
+	// per is the pattern for compound units, such as "{0}/{1}".
+	per [numWidths]string
+
+	// perUnit maps unit IDs to patterns for using the unit as a
+	// denominator, such as "{0}/h".
+	perUnit map[string][numWidths]string
+
+	// lists holds the patterns for joining the components of a mixed unit,
+	// such as a decomposed duration. The first pattern is used to join all
+	// but the last element, the second to join the last element.
+	lists [numWidths][2]string
+}
+
+var locales = map[string]*localeUnits{
+	"und": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"", "{0} km", ""},
+			"meter":                   {"", "{0} m", ""},
+			"centimeter":              {"", "{0} cm", ""},
+			"millimeter":              {"", "{0} mm", ""},
+			"mile":                    {"", "{0} mi", ""},
+			"yard":                    {"", "{0} yd", ""},
+			"foot":                    {"", "{0} ft", ""},
+			"inch":                    {"", "{0} in", ""},
+			"kilogram":                {"", "{0} kg", ""},
+			"gram":                    {"", "{0} g", ""},
+			"pound":                   {"", "{0} lb", ""},
+			"ounce":                   {"", "{0} oz", ""},
+			"year":                    {"", "{0} y", ""},
+			"month":                   {"", "{0} m", ""},
+			"week":                    {"", "{0} w", ""},
+			"day":                     {"", "{0} d", ""},
+			"hour":                    {"", "{0} h", ""},
+			"minute":                  {"", "{0} min", ""},
+			"second":                  {"", "{0} s", ""},
+			"millisecond":             {"", "{0} ms", ""},
+			"celsius":                 {"", "{0}°C", ""},
+			"fahrenheit":              {"", "{0}°F", ""},
+			"kelvin":                  {"", "{0} K", ""},
+			"liter":                   {"", "{0} l", ""},
+			"milliliter":              {"", "{0} ml", ""},
+			"gallon":                  {"", "{0} gal", ""},
+			"kilometer-per-hour":      {"", "{0} km/h", ""},
+			"mile-per-hour":           {"", "{0} mi/h", ""},
+			"meter-per-second":        {"", "{0} m/s", ""},
+			"liter-per-100kilometers": {"", "{0} l/100km", ""},
+			"mile-per-gallon":         {"", "{0} mpg", ""},
+			"byte":                    {"", "{0} byte", ""},
+			"kilobyte":                {"", "{0} kB", ""},
+			"megabyte":                {"", "{0} MB", ""},
+			"gigabyte":                {"", "{0} GB", ""},
+			"percent":                 {"", "{0}%", ""},
+		},
+		per:   [numWidths]string{"", "{0}/{1}", ""},
+		lists: [numWidths][2]string{{"", ""}, {"{0}, {1}", "{0}, {1}"}, {"", ""}},
+	},
+	"en": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"one={0} kilometer|other={0} kilometers", "{0} km", "{0}km"},
+			"meter":                   {"one={0} meter|other={0} meters", "{0} m", "{0}m"},
+			"centimeter":              {"one={0} centimeter|other={0} centimeters", "{0} cm", "{0}cm"},
+			"millimeter":              {"one={0} millimeter|other={0} millimeters", "{0} mm", "{0}mm"},
+			"mile":                    {"one={0} mile|other={0} miles", "{0} mi", "{0}mi"},
+			"yard":                    {"one={0} yard|other={0} yards", "{0} yd", "{0}yd"},
+			"foot":                    {"one={0} foot|other={0} feet", "{0} ft", "{0}′"},
+			"inch":                    {"one={0} inch|other={0} inches", "{0} in", "{0}″"},
+			"kilogram":                {"one={0} kilogram|other={0} kilograms", "{0} kg", "{0}kg"},
+			"gram":                    {"one={0} gram|other={0} grams", "{0} g", "{0}g"},
+			"pound":                   {"one={0} pound|other={0} pounds", "{0} lb", "{0}#"},
+			"ounce":                   {"one={0} ounce|other={0} ounces", "{0} oz", "{0}oz"},
+			"year":                    {"one={0} year|other={0} years", "one={0} yr|other={0} yrs", "{0}y"},
+			"month":                   {"one={0} month|other={0} months", "one={0} mth|other={0} mths", "{0}m"},
+			"week":                    {"one={0} week|other={0} weeks", "one={0} wk|other={0} wks", "{0}w"},
+			"day":                     {"one={0} day|other={0} days", "one={0} day|other={0} days", "{0}d"},
+			"hour":                    {"one={0} hour|other={0} hours", "{0} hr", "{0}h"},
+			"minute":                  {"one={0} minute|other={0} minutes", "{0} min", "{0}m"},
+			"second":                  {"one={0} second|other={0} seconds", "{0} sec", "{0}s"},
+			"millisecond":             {"one={0} millisecond|other={0} milliseconds", "{0} ms", "{0}ms"},
+			"celsius":                 {"one={0} degree Celsius|other={0} degrees Celsius", "{0}°C", "{0}°C"},
+			"fahrenheit":              {"one={0} degree Fahrenheit|other={0} degrees Fahrenheit", "{0}°F", "{0}°"},
+			"kelvin":                  {"one={0} kelvin|other={0} kelvins", "{0} K", "{0}K"},
+			"liter":                   {"one={0} liter|other={0} liters", "{0} L", "{0}L"},
+			"milliliter":              {"one={0} milliliter|other={0} milliliters", "{0} mL", "{0}mL"},
+			"gallon":                  {"one={0} gallon|other={0} gallons", "{0} gal", "{0}gal"},
+			"kilometer-per-hour":      {"one={0} kilometer per hour|other={0} kilometers per hour", "{0} km/h", "{0}km/h"},
+			"mile-per-hour":           {"one={0} mile per hour|other={0} miles per hour", "{0} mph", "{0}mph"},
+			"meter-per-second":        {"one={0} meter per second|other={0} meters per second", "{0} m/s", "{0}m/s"},
+			"liter-per-100kilometers": {"one={0} liter per 100 kilometers|other={0} liters per 100 kilometers", "{0} L/100km", "{0}L/100km"},
+			"mile-per-gallon":         {"one={0} mile per gallon|other={0} miles per gallon", "{0} mpg", "{0}mpg"},
+			"byte":                    {"one={0} byte|other={0} bytes", "{0} byte", "{0}B"},
+			"kilobyte":                {"one={0} kilobyte|other={0} kilobytes", "{0} kB", "{0}kB"},
+			"megabyte":                {"one={0} megabyte|other={0} megabytes", "{0} MB", "{0}MB"},
+			"gigabyte":                {"one={0} gigabyte|other={0} gigabytes", "{0} GB", "{0}GB"},
+			"percent":                 {"{0} percent", "{0}%", "{0}%"},
+		},
+		per: [numWidths]string{"{0} per {1}", "{0}/{1}", "{0}/{1}"},
+		perUnit: map[string][numWidths]string{
+			"year":   {"{0} per year", "{0}/y", ""},
+			"month":  {"{0} per month", "{0}/m", ""},
+			"week":   {"{0} per week", "{0}/w", ""},
+			"day":    {"{0} per day", "{0}/d", ""},
+			"hour":   {"{0} per hour", "{0}/h", ""},
+			"minute": {"{0} per minute", "{0}/min", ""},
+			"second": {"{0} per second", "{0}/s", ""},
+		},
+		lists: [numWidths][2]string{{"{0}, {1}", "{0}, {1}"}, {"{0}, {1}", "{0}, {1}"}, {"{0} {1}", "{0} {1}"}},
+	},
+	"de": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"{0} Kilometer", "{0} km", ""},
+			"meter":                   {"{0} Meter", "{0} m", ""},
+			"centimeter":              {"{0} Zentimeter", "{0} cm", ""},
+			"millimeter":              {"{0} Millimeter", "{0} mm", ""},
+			"mile":                    {"one={0} Meile|other={0} Meilen", "{0} mi", ""},
+			"yard":                    {"{0} Yard", "{0} yd", ""},
+			"foot":                    {"{0} Fuß", "{0} ft", ""},
+			"inch":                    {"{0} Zoll", "{0} in", ""},
+			"kilogram":                {"{0} Kilogramm", "{0} kg", ""},
+			"gram":                    {"{0} Gramm", "{0} g", ""},
+			"pound":                   {"{0} Pfund", "{0} lb", ""},
+			"ounce":                   {"one={0} Unze|other={0} Unzen", "{0} oz", ""},
+			"year":                    {"one={0} Jahr|other={0} Jahre", "{0} J.", ""},
+			"month":                   {"one={0} Monat|other={0} Monate", "{0} Mon.", "{0} M."},
+			"week":                    {"one={0} Woche|other={0} Wochen", "{0} Wo.", "{0} W."},
+			"day":                     {"one={0} Tag|other={0} Tage", "{0} Tg.", "{0} T."},
+			"hour":                    {"one={0} Stunde|other={0} Stunden", "{0} Std.", ""},
+			"minute":                  {"one={0} Minute|other={0} Minuten", "{0} Min.", ""},
+			"second":                  {"one={0} Sekunde|other={0} Sekunden", "{0} Sek.", "{0} s"},
+			"millisecond":             {"one={0} Millisekunde|other={0} Millisekunden", "{0} ms", ""},
+			"celsius":                 {"{0} Grad Celsius", "{0} °C", "{0}°C"},
+			"fahrenheit":              {"{0} Grad Fahrenheit", "{0} °F", "{0}°F"},
+			"kelvin":                  {"{0} Kelvin", "{0} K", ""},
+			"liter":                   {"{0} Liter", "{0} l", ""},
+			"milliliter":              {"{0} Milliliter", "{0} ml", ""},
+			"gallon":                  {"one={0} Gallone|other={0} Gallonen", "{0} gal", ""},
+			"kilometer-per-hour":      {"{0} Kilometer pro Stunde", "{0} km/h", ""},
+			"mile-per-hour":           {"one={0} Meile pro Stunde|other={0} Meilen pro Stunde", "{0} mi/h", ""},
+			"meter-per-second":        {"{0} Meter pro Sekunde", "{0} m/s", ""},
+			"liter-per-100kilometers": {"{0} Liter auf 100 Kilometer", "{0} l/100 km", ""},
+			"mile-per-gallon":         {"one={0} Meile pro Gallone|other={0} Meilen pro Gallone", "{0} mpg", ""},
+			"byte":                    {"{0} Byte", "{0} Byte", "{0} B"},
+			"kilobyte":                {"{0} Kilobyte", "{0} kB", ""},
+			"megabyte":                {"{0} Megabyte", "{0} MB", ""},
+			"gigabyte":                {"{0} Gigabyte", "{0} GB", ""},
+			"percent":                 {"{0} Prozent", "{0} %", ""},
+		},
+		per: [numWidths]string{"{0} pro {1}", "{0}/{1}", ""},
+		perUnit: map[string][numWidths]string{
+			"day":    {"{0} pro Tag", "{0}/T", ""},
+			"hour":   {"{0} pro Stunde", "{0}/h", ""},
+			"minute": {"{0} pro Minute", "{0}/min", ""},
+			"second": {"{0} pro Sekunde", "{0}/s", ""},
+		},
+		lists: [numWidths][2]string{{"{0}, {1}", "{0} und {1}"}, {"{0}, {1}", "{0} und {1}"}, {"{0}, {1}", "{0} und {1}"}},
+	},
+	"fr": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"one={0} kilomètre|other={0} kilomètres", "{0} km", "{0}km"},
+			"meter":                   {"one={0} mètre|other={0} mètres", "{0} m", "{0}m"},
+			"centimeter":              {"one={0} centimètre|other={0} centimètres", "{0} cm", "{0}cm"},
+			"millimeter":              {"one={0} millimètre|other={0} millimètres", "{0} mm", "{0}mm"},
+			"mile":                    {"one={0} mile|other={0} miles", "{0} mi", "{0}mi"},
+			"yard":                    {"one={0} yard|other={0} yards", "{0} yd", "{0}yd"},
+			"foot":                    {"one={0} pied|other={0} pieds", "{0} pi", "{0}′"},
+			"inch":                    {"one={0} pouce|other={0} pouces", "{0} po", "{0}″"},
+			"kilogram":                {"one={0} kilogramme|other={0} kilogrammes", "{0} kg", "{0}kg"},
+			"gram":                    {"one={0} gramme|other={0} grammes", "{0} g", "{0}g"},
+			"pound":                   {"one={0} livre|other={0} livres", "{0} lb", "{0}lb"},
+			"ounce":                   {"one={0} once|other={0} onces", "{0} oz", "{0}oz"},
+			"year":                    {"one={0} an|other={0} ans", "one={0} an|other={0} ans", "{0}a"},
+			"month":                   {"{0} mois", "{0} m.", "{0}m."},
+			"week":                    {"one={0} semaine|other={0} semaines", "{0} sem.", "{0}sem."},
+			"day":                     {"one={0} jour|other={0} jours", "{0} j", "{0}j"},
+			"hour":                    {"one={0} heure|other={0} heures", "{0} h", "{0}h"},
+			"minute":                  {"one={0} minute|other={0} minutes", "{0} min", "{0}min"},
+			"second":                  {"one={0} seconde|other={0} secondes", "{0} s", "{0}s"},
+			"millisecond":             {"one={0} milliseconde|other={0} millisecondes", "{0} ms", "{0}ms"},
+			"celsius":                 {"one={0} degré Celsius|other={0} degrés Celsius", "{0} °C", "{0}°C"},
+			"fahrenheit":              {"one={0} degré Fahrenheit|other={0} degrés Fahrenheit", "{0} °F", "{0}°F"},
+			"kelvin":                  {"one={0} kelvin|other={0} kelvins", "{0} K", "{0}K"},
+			"liter":                   {"one={0} litre|other={0} litres", "{0} l", "{0}l"},
+			"milliliter":              {"one={0} millilitre|other={0} millilitres", "{0} ml", "{0}ml"},
+			"gallon":                  {"one={0} gallon|other={0} gallons", "{0} gal", "{0}gal"},
+			"kilometer-per-hour":      {"one={0} kilomètre à l’heure|other={0} kilomètres à l’heure", "{0} km/h", "{0}km/h"},
+			"mile-per-hour":           {"one={0} mile à l’heure|other={0} miles à l’heure", "{0} mi/h", "{0}mi/h"},
+			"meter-per-second":        {"one={0} mètre par seconde|other={0} mètres par seconde", "{0} m/s", "{0}m/s"},
+			"liter-per-100kilometers": {"one={0} litre aux 100 kilomètres|other={0} litres aux 100 kilomètres", "{0} l/100 km", "{0}l/100km"},
+			"mile-per-gallon":         {"one={0} mile par gallon|other={0} miles par gallon", "{0} mpg", "{0}mpg"},
+			"byte":                    {"one={0} octet|other={0} octets", "{0} o", "{0}o"},
+			"kilobyte":                {"one={0} kilooctet|other={0} kilooctets", "{0} ko", "{0}ko"},
+			"megabyte":                {"one={0} mégaoctet|other={0} mégaoctets", "{0} Mo", "{0}Mo"},
+			"gigabyte":                {"one={0} gigaoctet|other={0} gigaoctets", "{0} Go", "{0}Go"},
+			"percent":                 {"{0} pour cent", "{0} %", "{0}%"},
+		},
+		per: [numWidths]string{"{0} par {1}", "{0}/{1}", "{0}/{1}"},
+		perUnit: map[string][numWidths]string{
+			"day":    {"{0} par jour", "{0}/j", ""},
+			"hour":   {"{0} par heure", "{0}/h", ""},
+			"minute": {"{0} par minute", "{0}/min", ""},
+			"second": {"{0} par seconde", "{0}/s", ""},
+		},
+		lists: [numWidths][2]string{{"{0}, {1}", "{0} et {1}"}, {"{0}, {1}", "{0} et {1}"}, {"{0} {1}", "{0} {1}"}},
+	},
+	"es": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"one={0} kilómetro|other={0} kilómetros", "{0} km", "{0}km"},
+			"meter":                   {"one={0} metro|other={0} metros", "{0} m", "{0}m"},
+			"centimeter":              {"one={0} centímetro|other={0} centímetros", "{0} cm", "{0}cm"},
+			"millimeter":              {"one={0} milímetro|other={0} milímetros", "{0} mm", "{0}mm"},
+			"mile":                    {"one={0} milla|other={0} millas", "{0} mi", "{0}mi"},
+			"yard":                    {"one={0} yarda|other={0} yardas", "{0} yd", "{0}yd"},
+			"foot":                    {"one={0} pie|other={0} pies", "{0} ft", "{0}ft"},
+			"inch":                    {"one={0} pulgada|other={0} pulgadas", "{0} in", "{0}in"},
+			"kilogram":                {"one={0} kilogramo|other={0} kilogramos", "{0} kg", "{0}kg"},
+			"gram":                    {"one={0} gramo|other={0} gramos", "{0} g", "{0}g"},
+			"pound":                   {"one={0} libra|other={0} libras", "{0} lb", "{0}lb"},
+			"ounce":                   {"one={0} onza|other={0} onzas", "{0} oz", "{0}oz"},
+			"year":                    {"one={0} año|other={0} años", "{0} a", "{0}a"},
+			"month":                   {"one={0} mes|other={0} meses", "{0} m.", "{0}m."},
+			"week":                    {"one={0} semana|other={0} semanas", "{0} sem.", "{0}sem."},
+			"day":                     {"one={0} día|other={0} días", "{0} d", "{0}d"},
+			"hour":                    {"one={0} hora|other={0} horas", "{0} h", "{0}h"},
+			"minute":                  {"one={0} minuto|other={0} minutos", "{0} min", "{0}min"},
+			"second":                  {"one={0} segundo|other={0} segundos", "{0} s", "{0}s"},
+			"millisecond":             {"one={0} milisegundo|other={0} milisegundos", "{0} ms", "{0}ms"},
+			"celsius":                 {"one={0} grado Celsius|other={0} grados Celsius", "{0} °C", "{0}°"},
+			"fahrenheit":              {"one={0} grado Fahrenheit|other={0} grados Fahrenheit", "{0} °F", "{0}°F"},
+			"kelvin":                  {"one={0} kelvin|other={0} kelvins", "{0} K", "{0}K"},
+			"liter":                   {"one={0} litro|other={0} litros", "{0} l", "{0}l"},
+			"milliliter":              {"one={0} mililitro|other={0} mililitros", "{0} ml", "{0}ml"},
+			"gallon":                  {"one={0} galón|other={0} galones", "{0} gal", "{0}gal"},
+			"kilometer-per-hour":      {"one={0} kilómetro por hora|other={0} kilómetros por hora", "{0} km/h", "{0}km/h"},
+			"mile-per-hour":           {"one={0} milla por hora|other={0} millas por hora", "{0} mi/h", "{0}mi/h"},
+			"meter-per-second":        {"one={0} metro por segundo|other={0} metros por segundo", "{0} m/s", "{0}m/s"},
+			"liter-per-100kilometers": {"one={0} litro por 100 kilómetros|other={0} litros por 100 kilómetros", "{0} l/100 km", "{0}l/100km"},
+			"mile-per-gallon":         {"one={0} milla por galón|other={0} millas por galón", "{0} mpg", "{0}mpg"},
+			"byte":                    {"one={0} byte|other={0} bytes", "{0} B", "{0}B"},
+			"kilobyte":                {"one={0} kilobyte|other={0} kilobytes", "{0} kB", "{0}kB"},
+			"megabyte":                {"one={0} megabyte|other={0} megabytes", "{0} MB", "{0}MB"},
+			"gigabyte":                {"one={0} gigabyte|other={0} gigabytes", "{0} GB", "{0}GB"},
+			"percent":                 {"{0} por ciento", "{0} %", "{0}%"},
+		},
+		per: [numWidths]string{"{0} por {1}", "{0}/{1}", "{0}/{1}"},
+		perUnit: map[string][numWidths]string{
+			"day":    {"{0} por día", "{0}/d", ""},
+			"hour":   {"{0} por hora", "{0}/h", ""},
+			"minute": {"{0} por minuto", "{0}/min", ""},
+			"second": {"{0} por segundo", "{0}/s", ""},
+		},
+		lists: [numWidths][2]string{{"{0}, {1}", "{0} y {1}"}, {"{0}, {1}", "{0} y {1}"}, {"{0} {1}", "{0} {1}"}},
+	},
+	"ja": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"{0} キロメートル", "{0} km", "{0}km"},
+			"meter":                   {"{0} メートル", "{0} m", "{0}m"},
+			"centimeter":              {"{0} センチメートル", "{0} cm", "{0}cm"},
+			"millimeter":              {"{0} ミリメートル", "{0} mm", "{0}mm"},
+			"mile":                    {"{0} マイル", "{0} マイル", "{0}mi"},
+			"yard":                    {"{0} ヤード", "{0} yd", "{0}yd"},
+			"foot":                    {"{0} フィート", "{0} ft", "{0}ft"},
+			"inch":                    {"{0} インチ", "{0} in", "{0}in"},
+			"kilogram":                {"{0} キログラム", "{0} kg", "{0}kg"},
+			"gram":                    {"{0} グラム", "{0} g", "{0}g"},
+			"pound":                   {"{0} ポンド", "{0} lb", "{0}lb"},
+			"ounce":                   {"{0} オンス", "{0} oz", "{0}oz"},
+			"year":                    {"{0} 年", "{0} 年", "{0}y"},
+			"month":                   {"{0} か月", "{0} か月", "{0}m"},
+			"week":                    {"{0} 週間", "{0} 週間", "{0}w"},
+			"day":                     {"{0} 日", "{0} 日", "{0}d"},
+			"hour":                    {"{0} 時間", "{0} 時間", "{0}h"},
+			"minute":                  {"{0} 分", "{0} 分", "{0}m"},
+			"second":                  {"{0} 秒", "{0} 秒", "{0}s"},
+			"millisecond":             {"{0} ミリ秒", "{0} ms", "{0}ms"},
+			"celsius":                 {"摂氏 {0} 度", "{0}°C", "{0}°C"},
+			"fahrenheit":              {"華氏 {0} 度", "{0}°F", "{0}°F"},
+			"kelvin":                  {"{0} ケルビン", "{0} K", "{0}K"},
+			"liter":                   {"{0} リットル", "{0} L", "{0}L"},
+			"milliliter":              {"{0} ミリリットル", "{0} mL", "{0}mL"},
+			"gallon":                  {"{0} ガロン", "{0} gal", "{0}gal"},
+			"kilometer-per-hour":      {"時速 {0} キロメートル", "{0} km/h", "{0}km/h"},
+			"mile-per-hour":           {"時速 {0} マイル", "{0} mph", "{0}mph"},
+			"meter-per-second":        {"秒速 {0} メートル", "{0} m/s", "{0}m/s"},
+			"liter-per-100kilometers": {"{0} リットル/100 km", "{0} L/100 km", "{0}L/100km"},
+			"mile-per-gallon":         {"{0} マイル/ガロン", "{0} mpg", "{0}mpg"},
+			"byte":                    {"{0} バイト", "{0} byte", "{0}B"},
+			"kilobyte":                {"{0} キロバイト", "{0} kB", "{0}kB"},
+			"megabyte":                {"{0} メガバイト", "{0} MB", "{0}MB"},
+			"gigabyte":                {"{0} ギガバイト", "{0} GB", "{0}GB"},
+			"percent":                 {"{0} パーセント", "{0}%", "{0}%"},
+		},
+		per: [numWidths]string{"{0} 毎 {1}", "{0}/{1}", "{0}/{1}"},
+		perUnit: map[string][numWidths]string{
+			"hour":   {"1 時間あたり {0}", "{0}/h", ""},
+			"minute": {"1 分あたり {0}", "{0}/min", ""},
+			"second": {"1 秒あたり {0}", "{0}/s", ""},
+		},
+		lists: [numWidths][2]string{{"{0} {1}", "{0} {1}"}, {"{0} {1}", "{0} {1}"}, {"{0}{1}", "{0}{1}"}},
+	},
+	"ru": {
+		patterns: map[string][numWidths]string{
+			"kilometer":               {"one={0} километр|few={0} километра|many={0} километров|other={0} километра", "{0} км", ""},
+			"meter":                   {"one={0} метр|few={0} метра|many={0} метров|other={0} метра", "{0} м", ""},
+			"centimeter":              {"one={0} сантиметр|few={0} сантиметра|many={0} сантиметров|other={0} сантиметра", "{0} см", ""},
+			"millimeter":              {"one={0} миллиметр|few={0} миллиметра|many={0} миллиметров|other={0} миллиметра", "{0} мм", ""},
+			"mile":                    {"one={0} миля|few={0} мили|many={0} миль|other={0} мили", "{0} ми", ""},
+			"yard":                    {"one={0} ярд|few={0} ярда|many={0} ярдов|other={0} ярда", "{0} ярд.", ""},
+			"foot":                    {"one={0} фут|few={0} фута|many={0} футов|other={0} фута", "{0} фт", ""},
+			"inch":                    {"one={0} дюйм|few={0} дюйма|many={0} дюймов|other={0} дюйма", "{0} дюйм.", ""},
+			"kilogram":                {"one={0} килограмм|few={0} килограмма|many={0} килограммов|other={0} килограмма", "{0} кг", ""},
+			"gram":                    {"one={0} грамм|few={0} грамма|many={0} граммов|other={0} грамма", "{0} г", ""},
+			"pound":                   {"one={0} фунт|few={0} фунта|many={0} фунтов|other={0} фунта", "{0} фнт", ""},
+			"ounce":                   {"one={0} унция|few={0} унции|many={0} унций|other={0} унции", "{0} унц.", ""},
+			"year":                    {"one={0} год|few={0} года|many={0} лет|other={0} года", "one={0} г.|few={0} г.|many={0} л.|other={0} г.", ""},
+			"month":                   {"one={0} месяц|few={0} месяца|many={0} месяцев|other={0} месяца", "{0} мес.", ""},
+			"week":                    {"one={0} неделя|few={0} недели|many={0} недель|other={0} недели", "{0} нед.", ""},
+			"day":                     {"one={0} день|few={0} дня|many={0} дней|other={0} дня", "{0} дн.", "{0} д"},
+			"hour":                    {"one={0} час|few={0} часа|many={0} часов|other={0} часа", "{0} ч", ""},
+			"minute":                  {"one={0} минута|few={0} минуты|many={0} минут|other={0} минуты", "{0} мин", ""},
+			"second":                  {"one={0} секунда|few={0} секунды|many={0} секунд|other={0} секунды", "{0} с", ""},
+			"millisecond":             {"one={0} миллисекунда|few={0} миллисекунды|many={0} миллисекунд|other={0} миллисекунды", "{0} мс", ""},
+			"celsius":                 {"one={0} градус Цельсия|few={0} градуса Цельсия|many={0} градусов Цельсия|other={0} градуса Цельсия", "{0} °C", "{0}°"},
+			"fahrenheit":              {"one={0} градус Фаренгейта|few={0} градуса Фаренгейта|many={0} градусов Фаренгейта|other={0} градуса Фаренгейта", "{0} °F", ""},
+			"kelvin":                  {"one={0} кельвин|few={0} кельвина|many={0} кельвинов|other={0} кельвина", "{0} K", ""},
+			"liter":                   {"one={0} литр|few={0} литра|many={0} литров|other={0} литра", "{0} л", ""},
+			"milliliter":              {"one={0} миллилитр|few={0} миллилитра|many={0} миллилитров|other={0} миллилитра", "{0} мл", ""},
+			"gallon":                  {"one={0} галлон|few={0} галлона|many={0} галлонов|other={0} галлона", "{0} гал", ""},
+			"kilometer-per-hour":      {"one={0} километр в час|few={0} километра в час|many={0} километров в час|other={0} километра в час", "{0} км/ч", ""},
+			"mile-per-hour":           {"one={0} миля в час|few={0} мили в час|many={0} миль в час|other={0} мили в час", "{0} ми/ч", ""},
+			"meter-per-second":        {"one={0} метр в секунду|few={0} метра в секунду|many={0} метров в секунду|other={0} метра в секунду", "{0} м/с", ""},
+			"liter-per-100kilometers": {"one={0} литр на 100 км|few={0} литра на 100 км|many={0} литров на 100 км|other={0} литра на 100 км", "{0} л/100 км", ""},
+			"mile-per-gallon":         {"one={0} миля на галлон|few={0} мили на галлон|many={0} миль на галлон|other={0} мили на галлон", "{0} миль/гал", ""},
+			"byte":                    {"one={0} байт|few={0} байта|many={0} байт|other={0} байта", "{0} Б", ""},
+			"kilobyte":                {"one={0} килобайт|few={0} килобайта|many={0} килобайт|other={0} килобайта", "{0} кБ", ""},
+			"megabyte":                {"one={0} мегабайт|few={0} мегабайта|many={0} мегабайт|other={0} мегабайта", "{0} МБ", ""},
+			"gigabyte":                {"one={0} гигабайт|few={0} гигабайта|many={0} гигабайт|other={0} гигабайта", "{0} ГБ", ""},
+			"percent":                 {"one={0} процент|few={0} процента|many={0} процентов|other={0} процента", "{0} %", ""},
+		},
+		per: [numWidths]string{"{0} на {1}", "{0}/{1}", ""},
+		perUnit: map[string][numWidths]string{
+			"day":    {"{0} в день", "{0}/д", ""},
+			"hour":   {"{0} в час", "{0}/ч", ""},
+			"minute": {"{0} в минуту", "{0}/мин", ""},
+			"second": {"{0} в секунду", "{0}/c", ""},
+		},
+		lists: [numWidths][2]string{{"{0}, {1}", "{0} и {1}"}, {"{0}, {1}", "{0} и {1}"}, {"{0} {1}", "{0} {1}"}},
+	},
+}
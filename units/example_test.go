@@ -0,0 +1,83 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units_test
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+	"golang.org/x/text/units"
+)
+
+func ExampleAmount() {
+	p := message.NewPrinter(language.English)
+	p.Println(units.Amount(12.5, units.Kilometer))
+	p.Println(units.Amount(12.5, units.Kilometer, units.Long()))
+	p.Println(units.Amount(1, units.Foot, units.Long()))
+	p.Println(units.Amount(2.345, units.Kilogram, units.Number(number.MaxFractionDigits(1))))
+	p.Println(units.Amount(100, units.Kilometer.Per(units.Hour)))
+
+	p = message.NewPrinter(language.Russian)
+	p.Println(units.Amount(5, units.Kilometer, units.Long()))
+	// Output:
+	// 12.5 km
+	// 12.5 kilometers
+	// 1 foot
+	// 2.3 kg
+	// 100 km/h
+	// 5 километров
+}
+
+func ExampleDuration() {
+	d := time.Hour + 5*time.Minute
+	p := message.NewPrinter(language.English)
+	p.Println(units.Duration(d))
+	p.Println(units.Duration(d, units.Long()))
+	p.Println(units.Duration(d, units.Narrow()))
+
+	p = message.NewPrinter(language.German)
+	p.Println(units.Duration(d, units.Long()))
+	// Output:
+	// 1 hr, 5 min
+	// 1 hour, 5 minutes
+	// 1h 5m
+	// 1 Stunde und 5 Minuten
+}
+
+func ExampleLocalize() {
+	distance := units.Amount(8, units.Kilometer,
+		units.Localize(units.Road),
+		units.Number(number.MaxFractionDigits(0)))
+	height := units.Amount(180, units.Centimeter, units.Localize(units.PersonHeight))
+
+	for _, tag := range []string{"en-US", "de-DE", "en-US-u-ms-metric"} {
+		p := message.NewPrinter(language.MustParse(tag))
+		p.Printf("%-18s %v, %v\n", tag+":", distance, height)
+	}
+	// Output:
+	// en-US:             5 mi, 5 ft, 11 in
+	// de-DE:             8 km, 180 cm
+	// en-US-u-ms-metric: 8 km, 180 cm
+}
+
+func ExampleConvert() {
+	x, _ := units.Convert(100, units.Celsius, units.Fahrenheit)
+	fmt.Printf("%.1f\n", x)
+
+	x, _ = units.Convert(10, units.LiterPer100Kilometers, units.MilePerGallon)
+	fmt.Printf("%.1f\n", x)
+	// Output:
+	// 212.0
+	// 23.5
+}
+
+func ExamplePreferred() {
+	x, u := units.Preferred(language.AmericanEnglish, 20, units.Celsius, units.Weather)
+	fmt.Printf("%.1f %v\n", x, u)
+	// Output: 68.0 fahrenheit
+}
@@ -0,0 +1,392 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/internal/format"
+	inumber "golang.org/x/text/internal/number"
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// Indices for the CLDR unit widths.
+const (
+	widthLong = iota
+	widthShort
+	widthNarrow
+
+	numWidths
+)
+
+// An Option configures a Formatter.
+type Option func(o *options)
+
+type options struct {
+	width  int
+	number []number.Option
+	usage  Usage
+}
+
+// Long formats units using their full names, such as "12 kilometers".
+func Long() Option {
+	return func(o *options) { o.width = widthLong }
+}
+
+// Short formats units using their abbreviations, such as "12 km". This is the
+// default.
+func Short() Option {
+	return func(o *options) { o.width = widthShort }
+}
+
+// Narrow formats units using the shortest available form, such as "12km".
+func Narrow() Option {
+	return func(o *options) { o.width = widthNarrow }
+}
+
+// Number sets options for formatting the numeric part of a measurement.
+func Number(opts ...number.Option) Option {
+	return func(o *options) {
+		o.number = append(o.number[:len(o.number):len(o.number)], opts...)
+	}
+}
+
+// Localize converts amounts to the unit preferred for the given usage in the
+// region of the language in which they are formatted. See Preferred for
+// details. Localize only applies to amounts of a Go numeric type.
+//
+// Converted amounts often have many fractional digits. Use Number to limit
+// these, for instance with number.MaxFractionDigits. Mixed units, such as
+// feet and inches, are rounded to a whole number of the smallest unit.
+func Localize(u Usage) Option {
+	return func(o *options) { o.usage = u }
+}
+
+// A Formatter formats a measurement. It implements fmt.Formatter.
+type Formatter struct {
+	value    interface{}
+	unit     Unit
+	duration time.Duration
+	options  []Option
+}
+
+// Amount formats the numeric value x in unit u. The value may be of any type
+// accepted by package number.
+func Amount(x interface{}, u Unit, opts ...Option) Formatter {
+	return Formatter{value: x, unit: u, options: opts}
+}
+
+// Duration formats d as a combination of days, hours, minutes, and seconds,
+// such as "1 hr, 5 min". Components that are zero are omitted. Durations of at
+// least one second are truncated to whole seconds. Shorter durations are
+// formatted in milliseconds.
+func Duration(d time.Duration, opts ...Option) Formatter {
+	return Formatter{duration: d, options: opts}
+}
+
+// Format implements fmt.Formatter. It formats the value in the language of s
+// if it implements format.State, and in the root language otherwise. Only the
+// verbs 'v' and 's' are supported.
+func (f Formatter) Format(s fmt.State, verb rune) {
+	if verb != 'v' && verb != 's' {
+		var v interface{} = f.value
+		if f.unit.index == 0 {
+			v = f.duration
+		}
+		fmt.Fprintf(s, "%%!%c(%T=%v)", verb, v, v)
+		return
+	}
+	lang := language.Und
+	if state, ok := s.(format.State); ok {
+		lang = state.Language()
+	}
+	var buf [64]byte
+	s.Write(f.AppendFormat(buf[:0], lang))
+}
+
+// AppendFormat appends the formatted measurement in language t to dst and
+// returns the extended buffer.
+func (f Formatter) AppendFormat(dst []byte, t language.Tag) []byte {
+	o := options{width: widthShort}
+	for _, opt := range f.options {
+		opt(&o)
+	}
+	if o.width < 0 || o.width >= numWidths {
+		o.width = widthShort
+	}
+	l := lookupLocale(t)
+	if f.unit.index == 0 {
+		return l.appendDuration(dst, t, &o, f.duration)
+	}
+	if x, ok := toFloat(f.value); ok && o.usage != "" {
+		v, units := preferred(t, x, f.unit, o.usage)
+		if len(units) > 1 {
+			return l.appendMixed(dst, t, &o, splitMixed(v, units))
+		}
+		return l.appendAmount(dst, t, &o, v, units[0])
+	}
+	return l.appendAmount(dst, t, &o, f.value, f.unit)
+}
+
+// toFloat converts x to a float64 if it is of a Go numeric type.
+func toFloat(x interface{}) (float64, bool) {
+	switch v := x.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// durationUnits lists the units into which a duration is decomposed.
+var durationUnits = []struct {
+	unit *Unit
+	d    time.Duration
+}{
+	{&Day, 24 * time.Hour},
+	{&Hour, time.Hour},
+	{&Minute, time.Minute},
+	{&Second, time.Second},
+}
+
+func (l *locale) appendDuration(dst []byte, t language.Tag, o *options, d time.Duration) []byte {
+	if d > -time.Second && d < time.Second {
+		ms := float64(d) / float64(time.Millisecond)
+		return l.appendAmount(dst, t, o, ms, Millisecond)
+	}
+	var parts []measure
+	for _, x := range durationUnits {
+		n := d / x.d
+		d -= n * x.d
+		if n == 0 {
+			continue
+		}
+		if n < 0 && len(parts) > 0 {
+			// Only the first part carries the sign.
+			n = -n
+		}
+		parts = append(parts, measure{int64(n), *x.unit})
+	}
+	return l.appendMixed(dst, t, o, parts)
+}
+
+// A measure is an amount of a unit.
+type measure struct {
+	value interface{}
+	unit  Unit
+}
+
+// appendMixed appends a list of measures using the list patterns of the
+// locale.
+func (l *locale) appendMixed(dst []byte, t language.Tag, o *options, parts []measure) []byte {
+	lists := l.lists[o.width]
+	if lists[0] == "" {
+		lists = l.lists[widthShort]
+	}
+	var b []byte
+	for i, p := range parts {
+		cur := l.appendAmount(nil, t, o, p.value, p.unit)
+		if i == 0 {
+			b = cur
+			continue
+		}
+		pattern := lists[0]
+		if i == len(parts)-1 {
+			pattern = lists[1]
+		}
+		b = []byte(substitute(pattern, string(b), string(cur)))
+	}
+	return append(dst, b...)
+}
+
+// appendAmount appends x formatted in unit u.
+func (l *locale) appendAmount(dst []byte, t language.Tag, o *options, x interface{}, u Unit) []byte {
+	var f inumber.Formatter
+	f.InitDecimal(t)
+	for _, opt := range o.number {
+		opt(t, &f)
+	}
+	var d inumber.Decimal
+	d.Convert(f.RoundingContext, x)
+	digits := inumber.FormatDigits(&d, f.RoundingContext)
+	form := plural.Cardinal.MatchDigits(t, digits.Digits, int(digits.Exp), digits.NumFracDigits())
+	num := string(f.Render(nil, digits))
+
+	var pattern string
+	if u.per == 0 {
+		pattern = l.pattern(u.index, o.width, form)
+	} else {
+		pattern = l.compound(u, o.width, form)
+	}
+	return append(dst, substitute(pattern, num)...)
+}
+
+// pattern returns the pattern for the given unit, width, and plural form.
+func (l *locale) pattern(unit uint16, width int, form plural.Form) string {
+	if p := pick(l.patterns[unit], width); p != "" {
+		return selectForm(p, form)
+	}
+	return "{0} " + unitInfos[unit].id
+}
+
+// compound returns the pattern for a compound unit that is not defined by
+// CLDR, using the per-unit pattern of the denominator if available, or the
+// generic compound pattern otherwise.
+func (l *locale) compound(u Unit, width int, form plural.Form) string {
+	num := l.pattern(u.index, width, form)
+	if p := pick(l.perUnit[u.per], width); p != "" {
+		return substitute(p, num)
+	}
+	// Use the singular form of the denominator without the number.
+	den := l.pattern(u.per, width, plural.One)
+	den = strings.TrimSpace(strings.Replace(den, "{0}", "", 1))
+	per := pick(l.per, width)
+	return substitute(per, num, den)
+}
+
+// pick returns the pattern for the given width, falling back to wider widths.
+// Long patterns fall back to short ones.
+func pick(p [numWidths]string, width int) string {
+	for w := width; w >= 0; w-- {
+		if p[w] != "" {
+			return p[w]
+		}
+	}
+	for w := width; w < numWidths; w++ {
+		if p[w] != "" {
+			return p[w]
+		}
+	}
+	return ""
+}
+
+// substitute replaces the placeholders {0}, {1}, etc. in pattern with args.
+func substitute(pattern string, args ...string) string {
+	for i, a := range args {
+		pattern = strings.Replace(pattern, "{"+string(rune('0'+i))+"}", a, 1)
+	}
+	return pattern
+}
+
+var pluralForms = map[string]plural.Form{
+	"other": plural.Other,
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+}
+
+// selectForm selects the pattern for the given plural form from a list of
+// patterns of the form "one={0} day|other={0} days", falling back to the
+// pattern for other. A pattern without forms is returned as is.
+func selectForm(patterns string, form plural.Form) string {
+	if !strings.Contains(patterns, "=") {
+		return patterns
+	}
+	other := ""
+	for _, s := range strings.Split(patterns, "|") {
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			continue
+		}
+		switch f := pluralForms[s[:i]]; f {
+		case form:
+			return s[i+1:]
+		case plural.Other:
+			other = s[i+1:]
+		}
+	}
+	return other
+}
+
+// A locale holds the unit data of a language, resolved against its parents.
+type locale struct {
+	patterns [][numWidths]string // indexed by unit index
+	per      [numWidths]string
+	perUnit  [][numWidths]string // indexed by unit index
+	lists    [numWidths][2]string
+}
+
+var localeCache sync.Map // map[language.Tag]*locale
+
+func lookupLocale(t language.Tag) *locale {
+	b, s, r := t.Raw()
+	key, _ := language.Compose(b, s, r)
+	if l, ok := localeCache.Load(key); ok {
+		return l.(*locale)
+	}
+	var chain []*localeUnits
+	for p := key; ; p = p.Parent() {
+		if d, ok := locales[p.String()]; ok {
+			chain = append(chain, d)
+		}
+		if p.IsRoot() {
+			break
+		}
+	}
+	l := &locale{
+		patterns: make([][numWidths]string, len(unitInfos)),
+		perUnit:  make([][numWidths]string, len(unitInfos)),
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		d := chain[i]
+		for j, u := range unitInfos {
+			if p, ok := d.patterns[u.id]; ok {
+				merge(&l.patterns[j], &p)
+			}
+			if p, ok := d.perUnit[u.id]; ok {
+				merge(&l.perUnit[j], &p)
+			}
+		}
+		merge(&l.per, &d.per)
+		for w, p := range d.lists {
+			if p[0] != "" {
+				l.lists[w] = p
+			}
+		}
+	}
+	localeCache.Store(key, l)
+	return l
+}
+
+// merge overwrites the patterns of dst with the non-empty patterns of src. If
+// src defines any pattern, narrower patterns of dst are cleared so that they
+// do not take precedence over the fallbacks of src.
+func merge(dst, src *[numWidths]string) {
+	for w, p := range src {
+		if p != "" {
+			dst[w] = p
+		} else if w > 0 && src[0] != "" {
+			dst[w] = ""
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		f    Formatter
+		want string
+	}{
+		{"en", Amount(12.5, Kilometer), "12.5 km"},
+		{"en", Amount(1, Kilometer, Long()), "1 kilometer"},
+		{"en", Amount(1.0, Kilometer, Long(), Number(number.Scale(1))), "1.0 kilometers"},
+		{"en", Amount(12.5, Kilometer, Long()), "12.5 kilometers"},
+		{"en", Amount(12.5, Kilometer, Narrow()), "12.5km"},
+		{"en", Amount(1, Foot, Long()), "1 foot"},
+		{"en", Amount(6, Foot, Long()), "6 feet"},
+		{"en", Amount(6, Foot, Narrow()), "6′"},
+		{"en", Amount(1, Year), "1 yr"},
+		{"en", Amount(2, Year), "2 yrs"},
+		{"en", Amount(1234567, Byte, Long()), "1,234,567 bytes"},
+		{"en", Amount(21.5, Celsius), "21.5°C"},
+		{"en", Amount(21.5, Celsius, Long()), "21.5 degrees Celsius"},
+		{"en", Amount(50, Percent), "50%"},
+		{"en", Amount(2.345, Kilogram, Number(number.MaxFractionDigits(1))), "2.3 kg"},
+		{"en", Amount(2, Kilogram, Number(number.MinFractionDigits(2))), "2.00 kg"},
+		{"en", Amount(1200, Meter, Number(number.NoSeparator())), "1200 m"},
+
+		// Compound units.
+		{"en", Amount(100, KilometerPerHour), "100 km/h"},
+		{"en", Amount(100, Kilometer.Per(Hour), Long()), "100 kilometers per hour"},
+		{"en", Amount(3, Meter.Per(Second), Long()), "3 meters per second"},
+		{"en", Amount(5, Liter.Per(Hour)), "5 L/h"},
+		{"en", Amount(5, Liter.Per(Hour), Long()), "5 liters per hour"},
+		{"en", Amount(1, Kilogram.Per(Liter), Long()), "1 kilogram per liter"},
+		{"en", Amount(5, Kilogram.Per(Liter)), "5 kg/L"},
+		{"de", Amount(5, Kilogram.Per(Liter), Long()), "5 Kilogramm pro Liter"},
+		{"fr", Amount(5, Gram.Per(Liter), Long()), "5 grammes par litre"},
+		{"und", Amount(5, Gram.Per(Liter), Long()), "5 g/l"},
+
+		// Languages.
+		{"de", Amount(12.5, Kilometer), "12,5 km"},
+		{"de", Amount(1, Hour, Long()), "1 Stunde"},
+		{"de", Amount(2, Hour, Long()), "2 Stunden"},
+		{"de", Amount(2, Hour, Narrow()), "2 Std."},
+		{"fr", Amount(1.5, Hour, Long()), "1,5 heure"},
+		{"fr", Amount(2, Hour, Long()), "2 heures"},
+		{"fr", Amount(12, Kilometer), "12 km"},
+		{"es", Amount(2, Month, Long()), "2 meses"},
+		{"ja", Amount(100, KilometerPerHour, Long()), "時速 100 キロメートル"},
+		{"ru", Amount(1, Kilometer, Long()), "1 километр"},
+		{"ru", Amount(3, Kilometer, Long()), "3 километра"},
+		{"ru", Amount(5, Kilometer, Long()), "5 километров"},
+		{"ru", Amount(1.5, Kilometer, Long()), "1,5 километра"},
+		{"en-GB", Amount(3, Mile, Long()), "3 miles"},
+		{"zh", Amount(3, Mile, Long()), "3 mi"},
+		{"ar-u-nu-arab", Amount(3, Mile), "٣ mi"},
+
+		// Localized units.
+		{"en", Amount(8, Kilometer, Localize(Road)), "4.971 mi"},
+		{"en", Amount(8, Kilometer, Localize(Road), Number(number.MaxFractionDigits(0))), "5 mi"},
+		{"de", Amount(5, Mile, Localize(Road), Number(number.MaxFractionDigits(0))), "8 km"},
+		{"en-u-ms-metric", Amount(5, Mile, Localize(Road), Long(), Number(number.MaxFractionDigits(0))), "8 kilometers"},
+		{"en", Amount(180, Centimeter, Localize(PersonHeight)), "5 ft, 11 in"},
+		{"en", Amount(180, Centimeter, Localize(PersonHeight), Long()), "5 feet, 11 inches"},
+		{"en", Amount(1.83, Meter, Localize(PersonHeight), Narrow()), "6′"},
+		{"de", Amount(71, Inch, Localize(PersonHeight)), "180,34 cm"},
+		{"en", Amount(20, Celsius, Localize(Weather)), "68°F"},
+		{"en", Amount(20, Celsius, Localize(Weather), Number(number.MaxFractionDigits(0))), "68°F"},
+		{"de-u-ms-ussystem", Amount(20, Celsius, Localize(Weather)), "68 °F"},
+		{"en", Amount(3, Hour, Localize(Default)), "3 hr"},
+
+		// Durations.
+		{"en", Duration(time.Hour + 5*time.Minute), "1 hr, 5 min"},
+		{"en", Duration(time.Hour+5*time.Minute, Narrow()), "1h 5m"},
+		{"en", Duration(time.Hour+5*time.Minute, Long()), "1 hour, 5 minutes"},
+		{"en", Duration(26*time.Hour+3*time.Second, Long()), "1 day, 2 hours, 3 seconds"},
+		{"en", Duration(90*time.Second + 500*time.Millisecond), "1 min, 30 sec"},
+		{"en", Duration(-90 * time.Minute), "-1 hr, 30 min"},
+		{"en", Duration(0), "0 ms"},
+		{"en", Duration(1500 * time.Microsecond), "1.5 ms"},
+		{"en", Duration(time.Hour, Long()), "1 hour"},
+		{"de", Duration(time.Hour+5*time.Minute, Long()), "1 Stunde und 5 Minuten"},
+		{"fr", Duration(3*time.Hour+5*time.Minute+2*time.Second, Long()), "3 heures, 5 minutes et 2 secondes"},
+		{"ja", Duration(time.Hour + 5*time.Minute), "1 時間 5 分"},
+		{"ru", Duration(2*time.Hour+21*time.Minute, Long()), "2 часа и 21 минута"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			tag := language.MustParse(tc.tag)
+			if got := string(tc.f.AppendFormat(nil, tag)); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	testCases := []struct {
+		tag    string
+		format string
+		arg    interface{}
+		want   string
+	}{
+		{"en", "%v", Amount(12.5, Kilometer), "12.5 km"},
+		{"de", "%v", Amount(12.5, Kilometer), "12,5 km"},
+		{"de", "%s", Duration(time.Minute), "1 Min."},
+		{"en", "%d", Amount(12, Kilometer), "%!d(int=12)"},
+		{"en", "%d", Duration(time.Minute), "%!d(time.Duration=1m0s)"},
+	}
+	for _, tc := range testCases {
+		p := message.NewPrinter(language.MustParse(tc.tag))
+		if got := p.Sprintf(tc.format, tc.arg); got != tc.want {
+			t.Errorf("%s:%s: got %q; want %q", tc.tag, tc.format, got, tc.want)
+		}
+	}
+}
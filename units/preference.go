@@ -0,0 +1,194 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import (
+	"math"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// A Usage identifies the context in which a measurement is used, such as a
+// road distance or the height of a person. The preferred unit for a quantity
+// may differ per usage. The values correspond to the CLDR usage identifiers.
+type Usage string
+
+// Usages for which CLDR defines unit preferences.
+const (
+	Default      Usage = "default"
+	Road         Usage = "road"
+	PersonHeight Usage = "person-height"
+	Person       Usage = "person" // the weight of a person
+	Weather      Usage = "weather"
+	VehicleFuel  Usage = "vehicle-fuel"
+)
+
+// A unitPreference defines the units preferred for amounts of at least geq,
+// expressed in the first of these units. More than one unit denotes a mixed
+// unit, such as feet and inches.
+type unitPreference struct {
+	regions string // space-separated list of region codes
+	geq     float64
+	units   []Unit
+}
+
+// preferences holds the CLDR unit preferences, keyed by category and usage.
+// For a given region, the first preference for which the amount is at least
+// geq is selected; the last one is used otherwise. The preferences of region
+// 001 apply to all regions that are not listed explicitly.
+var preferences = map[string][]unitPreference{
+	"length/default": {
+		{"001", 1, []Unit{Kilometer}},
+		{"001", 1, []Unit{Meter}},
+		{"001", 0, []Unit{Centimeter}},
+		{"US GB", 1, []Unit{Mile}},
+		{"US GB", 1, []Unit{Foot}},
+		{"US GB", 0, []Unit{Inch}},
+	},
+	"length/road": {
+		{"001", 0.9, []Unit{Kilometer}},
+		{"001", 0, []Unit{Meter}},
+		{"US", 0.5, []Unit{Mile}},
+		{"US", 0, []Unit{Foot}},
+		{"GB", 0.5, []Unit{Mile}},
+		{"GB", 0, []Unit{Yard}},
+	},
+	"length/person-height": {
+		{"001", 0, []Unit{Centimeter}},
+		{"US GB CA IN", 0, []Unit{Foot, Inch}},
+	},
+	"mass/default": {
+		{"001", 1, []Unit{Kilogram}},
+		{"001", 0, []Unit{Gram}},
+		{"US GB", 1, []Unit{Pound}},
+		{"US GB", 0, []Unit{Ounce}},
+	},
+	"mass/person": {
+		{"001", 0, []Unit{Kilogram}},
+		{"US", 0, []Unit{Pound}},
+	},
+	"temperature/default": {
+		{"001", 0, []Unit{Celsius}},
+		{"US BS BZ KY PR PW", 0, []Unit{Fahrenheit}},
+	},
+	"temperature/weather": {
+		{"001", 0, []Unit{Celsius}},
+		{"US BS BZ KY PR PW", 0, []Unit{Fahrenheit}},
+	},
+	"volume/default": {
+		{"001", 1, []Unit{Liter}},
+		{"001", 0, []Unit{Milliliter}},
+		{"US", 0, []Unit{Gallon}},
+	},
+	"speed/default": {
+		{"001", 0, []Unit{KilometerPerHour}},
+		{"US GB", 0, []Unit{MilePerHour}},
+	},
+	"consumption/vehicle-fuel": {
+		{"001", 0, []Unit{LiterPer100Kilometers}},
+		{"US", 0, []Unit{MilePerGallon}},
+	},
+}
+
+// measurementRegion returns the region determining the measurement system of
+// t. The Unicode extension keys "ms" (measurement system) and "rg" (region
+// override) take precedence over the region of t.
+func measurementRegion(t language.Tag) string {
+	switch t.TypeForKey("ms") {
+	case "metric":
+		return "001"
+	case "ussystem":
+		return "US"
+	case "uksystem":
+		return "GB"
+	}
+	if rg := t.TypeForKey("rg"); len(rg) > 2 {
+		if r, err := language.ParseRegion(rg[:2]); err == nil {
+			return r.String()
+		}
+	}
+	r, _ := t.Region()
+	return r.String()
+}
+
+// preferred returns the preferred units for the amount x of unit u, and the
+// amount converted to the first of these units. It returns x and u if there
+// are no preferences for u.
+func preferred(t language.Tag, x float64, u Unit, usage Usage) (float64, []Unit) {
+	cat := u.Category()
+	prefs, ok := preferences[cat+"/"+string(usage)]
+	if !ok {
+		prefs, ok = preferences[cat+"/default"]
+	}
+	if !ok {
+		return x, []Unit{u}
+	}
+	region := measurementRegion(t)
+	match := prefs[:0:0]
+	for _, p := range prefs {
+		if strings.Contains(" "+p.regions+" ", " "+region+" ") {
+			match = append(match, p)
+		}
+	}
+	if len(match) == 0 {
+		for _, p := range prefs {
+			if p.regions == "001" {
+				match = append(match, p)
+			}
+		}
+	}
+	for i, p := range match {
+		v, err := Convert(x, u, p.units[0])
+		if err != nil {
+			break
+		}
+		if i == len(match)-1 || math.Abs(v) >= p.geq {
+			return v, p.units
+		}
+	}
+	return x, []Unit{u}
+}
+
+// Preferred converts the amount x of unit u to the unit preferred for the
+// given usage in the region of t. The region is determined by the Unicode
+// extension keys "ms" and "rg" of t, if present, and by the region of t
+// otherwise. For instance, a road distance is converted to miles for
+// "en-US" and to kilometers for "de" and "en-US-u-ms-metric".
+//
+// For preferences consisting of multiple units, such as feet and inches for
+// the height of a person, the amount is converted to the largest of these.
+// Preferred returns x and u if no preferences are defined for the category
+// of u.
+func Preferred(t language.Tag, x float64, u Unit, usage Usage) (float64, Unit) {
+	v, prefs := preferred(t, x, u, usage)
+	return v, prefs[0]
+}
+
+// splitMixed splits the amount x of units[0] into amounts of each of the given
+// units, largest first. The last amount is rounded to the nearest integer.
+// Only the first amount carries the sign.
+func splitMixed(x float64, units []Unit) []measure {
+	last := units[len(units)-1]
+	rem, _ := Convert(math.Abs(x), units[0], last)
+	rem = math.Round(rem)
+	parts := make([]measure, 0, len(units))
+	for _, u := range units[:len(units)-1] {
+		size, _ := Convert(1, u, last)
+		size = math.Round(size*1e6) / 1e6 // remove conversion errors
+		n := math.Floor(rem / size)
+		rem = math.Round(rem - n*size)
+		if n != 0 {
+			parts = append(parts, measure{int64(n), u})
+		}
+	}
+	if rem != 0 || len(parts) == 0 {
+		parts = append(parts, measure{int64(rem), last})
+	}
+	if x < 0 {
+		parts[0].value = -parts[0].value.(int64)
+	}
+	return parts
+}
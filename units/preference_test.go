@@ -0,0 +1,99 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestMeasurementRegion(t *testing.T) {
+	testCases := []struct {
+		tag  string
+		want string
+	}{
+		{"en", "US"},
+		{"en-GB", "GB"},
+		{"de", "DE"},
+		{"und", "US"},
+		{"en-u-ms-metric", "001"},
+		{"de-u-ms-ussystem", "US"},
+		{"en-US-u-ms-uksystem", "GB"},
+		{"en-u-rg-dezzzz", "DE"},
+		{"en-u-ms-metric-rg-uszzzz", "001"},
+		{"en-u-rg-zz", "US"},
+	}
+	for _, tc := range testCases {
+		if got := measurementRegion(language.MustParse(tc.tag)); got != tc.want {
+			t.Errorf("%s: got %q; want %q", tc.tag, got, tc.want)
+		}
+	}
+}
+
+func TestPreferred(t *testing.T) {
+	testCases := []struct {
+		tag   string
+		x     float64
+		unit  Unit
+		usage Usage
+		want  float64
+		wantU Unit
+	}{
+		{"en", 8, Kilometer, Road, 4.97096954, Mile},
+		{"en", 0.5, Kilometer, Road, 1640.4199475, Foot},
+		{"en-GB", 0.5, Kilometer, Road, 546.80665, Yard},
+		{"de", 5, Mile, Road, 8.04672, Kilometer},
+		{"de", 0.5, Mile, Road, 804.672, Meter},
+		{"en-u-ms-metric", 5, Mile, Road, 8.04672, Kilometer},
+		{"de-u-ms-ussystem", 8, Kilometer, Road, 4.97096954, Mile},
+		{"fr", 5, Mile, Default, 8.04672, Kilometer},
+		{"fr", 3, Foot, Default, 91.44, Centimeter},
+		{"fr", 72, Inch, PersonHeight, 182.88, Centimeter},
+		{"en", 180, Centimeter, PersonHeight, 5.905511811, Foot},
+		{"en", 20, Celsius, Weather, 68, Fahrenheit},
+		{"nl", 68, Fahrenheit, Default, 20, Celsius},
+		{"en", 80, Kilogram, Person, 176.3698097, Pound},
+		{"en-GB", 80, Kilogram, Person, 80, Kilogram},
+		{"en", 200, Gram, Default, 7.054792389, Ounce},
+		{"en", 500, Gram, Default, 1.102311311, Pound},
+		{"en", 10, LiterPer100Kilometers, VehicleFuel, 23.5214583, MilePerGallon},
+		{"en", 100, KilometerPerHour, Road, 62.1371192, MilePerHour},
+		{"ja", 2, Gallon, Default, 7.570823568, Liter},
+
+		// No preferences.
+		{"en", 3, Hour, Default, 3, Hour},
+		{"en", 3, Kilogram.Per(Liter), Default, 3, Kilogram.Per(Liter)},
+	}
+	for _, tc := range testCases {
+		got, u := Preferred(language.MustParse(tc.tag), tc.x, tc.unit, tc.usage)
+		if u != tc.wantU || math.Abs(got-tc.want) > 1e-6 {
+			t.Errorf("%s:%v %v (%s): got %v %v; want %v %v",
+				tc.tag, tc.x, tc.unit, tc.usage, got, u, tc.want, tc.wantU)
+		}
+	}
+}
+
+func TestSplitMixed(t *testing.T) {
+	testCases := []struct {
+		x    float64
+		want []measure
+	}{
+		{5.9, []measure{{int64(5), Foot}, {int64(11), Inch}}},
+		{6, []measure{{int64(6), Foot}}},
+		{5.999, []measure{{int64(6), Foot}}},
+		{0.5, []measure{{int64(6), Inch}}},
+		{0, []measure{{int64(0), Inch}}},
+		{-5.5, []measure{{int64(-5), Foot}, {int64(6), Inch}}},
+	}
+	for _, tc := range testCases {
+		got := splitMixed(tc.x, []Unit{Foot, Inch})
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%v: got %v; want %v", tc.x, got, tc.want)
+		}
+	}
+}
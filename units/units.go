@@ -0,0 +1,209 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package units formats measurements, such as lengths, weights, and durations,
+// in a language-specific way.
+//
+// A measurement is formatted using the CLDR unit patterns for the language,
+// taking into account the plural form of the amount:
+//
+//	p := message.NewPrinter(language.English)
+//	p.Println(units.Amount(12.5, units.Kilometer, units.Long()))
+//	// Prints: 12.5 kilometers
+//
+// The numeric part of a measurement may be configured with the options of
+// package number:
+//
+//	p.Println(units.Amount(2.345, units.Kilogram,
+//		units.Number(number.MaxFractionDigits(1))))
+//	// Prints: 2.3 kg
+//
+// Amounts can be converted between units with Convert. The Localize option
+// converts an amount to the unit preferred in the region of the language,
+// as defined by the CLDR unit preferences:
+//
+//	d := units.Amount(8, units.Kilometer, units.Localize(units.Road),
+//		units.Number(number.MaxFractionDigits(0)))
+//	message.NewPrinter(language.AmericanEnglish).Println(d) // Prints: 5 mi
+//	message.NewPrinter(language.German).Println(d)          // Prints: 8 km
+package units // import "golang.org/x/text/units"
+
+import (
+	"errors"
+	"strings"
+)
+
+// A Unit is a unit of measurement, such as a kilometer, or a compound unit
+// of two units, such as a kilometer per hour.
+type Unit struct {
+	index uint16 // index into unitInfo; 0 for an invalid unit
+	per   uint16 // index of the denominator of a compound unit
+}
+
+type unitInfo struct {
+	category string
+	id       string
+}
+
+var unitInfos = []unitInfo{
+	{"", ""},
+	{"length", "kilometer"},
+	{"length", "meter"},
+	{"length", "centimeter"},
+	{"length", "millimeter"},
+	{"length", "mile"},
+	{"length", "yard"},
+	{"length", "foot"},
+	{"length", "inch"},
+	{"mass", "kilogram"},
+	{"mass", "gram"},
+	{"mass", "pound"},
+	{"mass", "ounce"},
+	{"duration", "year"},
+	{"duration", "month"},
+	{"duration", "week"},
+	{"duration", "day"},
+	{"duration", "hour"},
+	{"duration", "minute"},
+	{"duration", "second"},
+	{"duration", "millisecond"},
+	{"temperature", "celsius"},
+	{"temperature", "fahrenheit"},
+	{"temperature", "kelvin"},
+	{"volume", "liter"},
+	{"volume", "milliliter"},
+	{"volume", "gallon"},
+	{"speed", "kilometer-per-hour"},
+	{"speed", "mile-per-hour"},
+	{"speed", "meter-per-second"},
+	{"consumption", "liter-per-100kilometers"},
+	{"consumption", "mile-per-gallon"},
+	{"digital", "byte"},
+	{"digital", "kilobyte"},
+	{"digital", "megabyte"},
+	{"digital", "gigabyte"},
+	{"concentr", "percent"},
+}
+
+// Units supported by this package.
+var (
+	Kilometer  = MustParse("kilometer")
+	Meter      = MustParse("meter")
+	Centimeter = MustParse("centimeter")
+	Millimeter = MustParse("millimeter")
+	Mile       = MustParse("mile")
+	Yard       = MustParse("yard")
+	Foot       = MustParse("foot")
+	Inch       = MustParse("inch")
+
+	Kilogram = MustParse("kilogram")
+	Gram     = MustParse("gram")
+	Pound    = MustParse("pound")
+	Ounce    = MustParse("ounce")
+
+	Year        = MustParse("year")
+	Month       = MustParse("month")
+	Week        = MustParse("week")
+	Day         = MustParse("day")
+	Hour        = MustParse("hour")
+	Minute      = MustParse("minute")
+	Second      = MustParse("second")
+	Millisecond = MustParse("millisecond")
+
+	Celsius    = MustParse("celsius")
+	Fahrenheit = MustParse("fahrenheit")
+	Kelvin     = MustParse("kelvin")
+
+	Liter      = MustParse("liter")
+	Milliliter = MustParse("milliliter")
+	Gallon     = MustParse("gallon")
+
+	KilometerPerHour = MustParse("kilometer-per-hour")
+	MilePerHour      = MustParse("mile-per-hour")
+	MeterPerSecond   = MustParse("meter-per-second")
+
+	LiterPer100Kilometers = MustParse("liter-per-100kilometers")
+	MilePerGallon         = MustParse("mile-per-gallon")
+
+	Byte     = MustParse("byte")
+	Kilobyte = MustParse("kilobyte")
+	Megabyte = MustParse("megabyte")
+	Gigabyte = MustParse("gigabyte")
+
+	Percent = MustParse("percent")
+)
+
+var errUnknown = errors.New("units: unknown unit")
+
+// Parse parses a CLDR unit identifier, such as "kilometer", "length-meter",
+// or "kilogram-per-second". The category prefix is optional. Compound units of
+// two supported units may be given as "<unit>-per-<unit>".
+func Parse(s string) (Unit, error) {
+	if i := lookup(s); i > 0 {
+		return Unit{index: i}, nil
+	}
+	if i := strings.Index(s, "-per-"); i > 0 {
+		num, den := Unit{index: lookup(s[:i])}, Unit{index: lookup(s[i+len("-per-"):])}
+		if u := num.Per(den); u.index != 0 {
+			return u, nil
+		}
+	}
+	return Unit{}, errUnknown
+}
+
+func lookup(s string) uint16 {
+	for i, u := range unitInfos[1:] {
+		if s == u.id || strings.HasPrefix(s, u.category) &&
+			s[len(u.category):] == "-"+u.id {
+			return uint16(i + 1)
+		}
+	}
+	return 0
+}
+
+// MustParse is like Parse, but panics if the given unit cannot be parsed.
+// It simplifies safe initialization of Unit values.
+func MustParse(s string) Unit {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// Per returns the compound unit of u per d, such as a kilometer per hour. A
+// dedicated unit is returned if one is defined.
+func (u Unit) Per(d Unit) Unit {
+	if u.isCompound() || d.isCompound() || u.index == 0 || d.index == 0 {
+		return Unit{}
+	}
+	if i := lookup(unitInfos[u.index].id + "-per-" + unitInfos[d.index].id); i > 0 {
+		return Unit{index: i}
+	}
+	return Unit{index: u.index, per: d.index}
+}
+
+// isCompound reports whether u is a compound unit, including the compound
+// units defined by CLDR.
+func (u Unit) isCompound() bool {
+	return u.per != 0 || strings.Contains(unitInfos[u.index].id, "-per-")
+}
+
+// Category returns the CLDR category of u, such as "length" or "duration".
+// The category of a compound unit that is not defined by CLDR is empty.
+func (u Unit) Category() string {
+	if u.per != 0 {
+		return ""
+	}
+	return unitInfos[u.index].category
+}
+
+// String returns the CLDR identifier of u without its category, such as
+// "kilometer-per-hour".
+func (u Unit) String() string {
+	if u.per != 0 {
+		return unitInfos[u.index].id + "-per-" + unitInfos[u.per].id
+	}
+	return unitInfos[u.index].id
+}
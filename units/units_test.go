@@ -0,0 +1,60 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package units
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		in       string
+		want     string
+		category string
+		ok       bool
+	}{
+		{"kilometer", "kilometer", "length", true},
+		{"length-kilometer", "kilometer", "length", true},
+		{"duration-hour", "hour", "duration", true},
+		{"mass-hour", "", "", false},
+		{"kilometer-per-hour", "kilometer-per-hour", "speed", true},
+		{"speed-kilometer-per-hour", "kilometer-per-hour", "speed", true},
+		{"length-kilometer-per-duration-hour", "kilometer-per-hour", "speed", true},
+		{"kilogram-per-second", "kilogram-per-second", "", true},
+		{"kilogram-per-meter-per-second", "", "", false},
+		{"furlong", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tc := range testCases {
+		u, err := Parse(tc.in)
+		if ok := err == nil; ok != tc.ok {
+			t.Errorf("%s: error was %v; want %v", tc.in, err, tc.ok)
+			continue
+		}
+		if got := u.String(); got != tc.want {
+			t.Errorf("%s: got %q; want %q", tc.in, got, tc.want)
+		}
+		if got := u.Category(); got != tc.category {
+			t.Errorf("%s: category was %q; want %q", tc.in, got, tc.category)
+		}
+	}
+}
+
+func TestPer(t *testing.T) {
+	testCases := []struct {
+		num, den Unit
+		want     Unit
+	}{
+		{Kilometer, Hour, KilometerPerHour},
+		{Mile, Gallon, MilePerGallon},
+		{Kilogram, Second, Unit{Kilogram.index, Second.index}},
+		{KilometerPerHour, Second, Unit{}},
+		{Kilogram, Kilogram.Per(Second), Unit{}},
+		{Unit{}, Second, Unit{}},
+	}
+	for _, tc := range testCases {
+		if got := tc.num.Per(tc.den); got != tc.want {
+			t.Errorf("%v.Per(%v) = %v; want %v", tc.num, tc.den, got, tc.want)
+		}
+	}
+}